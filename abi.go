@@ -0,0 +1,114 @@
+package opendal
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/jupiterrider/ffi"
+)
+
+// capabilityGuard is the byte that fills a capabilityProbe before the C binding
+// writes into it. It is neither 0 nor 1, so a flag left unwritten is detected.
+const capabilityGuard = 0xa5
+
+// capabilityProbe receives an opendal_capability returned by the C binding. The
+// guard after the mirrored struct catches a C struct larger than the mirror.
+type capabilityProbe struct {
+	capability opendalCapability
+	guard      [256]byte
+}
+
+// checkCapability verifies that the layout of opendal_capability mirrored in
+// types.go matches the loaded C binding, using the capabilities of op.
+//
+// The C binding exports neither its version nor the size of its structs, so the
+// full capability is returned into a probe filled with capabilityGuard. A larger
+// C struct overwrites the guard, and a smaller one leaves its last fields unwritten.
+// Every flag of the full and native capabilities must then be either 0 or 1.
+func checkCapability(path string, syms *symbols, op *opendalOperator) error {
+	info := syms.operatorInfoNew(op)
+	defer syms.operatorInfoFree(info)
+
+	probe := &capabilityProbe{}
+	b := unsafe.Slice((*byte)(unsafe.Pointer(probe)), unsafe.Sizeof(*probe))
+	for i := range b {
+		b[i] = capabilityGuard
+	}
+	syms.operatorInfoProbeCapability(info, probe)
+	if err := checkCapabilityProbe(probe); err != nil {
+		return errIncompatible(path, err.Error())
+	}
+
+	for _, cap := range []*opendalCapability{
+		syms.operatorInfoGetFullCapability(info),
+		syms.operatorInfoGetNativeCapability(info),
+	} {
+		if err := checkCapabilityFlags(cap); err != nil {
+			return errIncompatible(path, err.Error())
+		}
+	}
+	return nil
+}
+
+// checkCapabilityProbe verifies that the C binding wrote exactly the mirrored
+// opendal_capability into probe.
+func checkCapabilityProbe(probe *capabilityProbe) error {
+	for _, b := range probe.guard {
+		if b != capabilityGuard {
+			return fmt.Errorf("opendal_capability is larger than %d bytes", unsafe.Sizeof(probe.capability))
+		}
+	}
+	v := reflect.ValueOf(&probe.capability).Elem()
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		b := unsafe.Slice((*byte)(unsafe.Add(unsafe.Pointer(&probe.capability), field.Offset)), field.Type.Size())
+		written := false
+		for _, c := range b {
+			written = written || c != capabilityGuard
+		}
+		if !written {
+			return fmt.Errorf("opendal_capability ends before %s", field.Name)
+		}
+	}
+	return checkCapabilityFlags(&probe.capability)
+}
+
+// checkCapabilityFlags verifies that every flag of cap is either 0 or 1. Any other
+// value means that the fields of the C struct are not where the mirror expects them.
+func checkCapabilityFlags(cap *opendalCapability) error {
+	v := reflect.ValueOf(cap).Elem()
+	for i := range v.NumField() {
+		field := v.Field(i)
+		if field.Kind() != reflect.Uint8 || field.Uint() <= 1 {
+			continue
+		}
+		return fmt.Errorf("opendal_capability.%s is %d, want 0 or 1", v.Type().Field(i).Name, field.Uint())
+	}
+	return nil
+}
+
+// errIncompatible reports that the library at path cannot be used by this binding.
+func errIncompatible(path, reason string) error {
+	return &Error{
+		code:    CodeUnsupported,
+		message: fmt.Sprintf("incompatible C binding %s: %s", path, reason),
+	}
+}
+
+// operatorInfoProbeCapability calls opendal_operator_info_get_full_capability like
+// operatorInfoGetFullCapability, but returns the struct into probe.
+type operatorInfoProbeCapability func(info *opendalOperatorInfo, probe *capabilityProbe)
+
+var withOperatorInfoProbeCapability = withFFI(ffiOpts{
+	sym:    symOperatorInfoGetFullCapability,
+	rType:  &typeCapability,
+	aTypes: []*ffi.Type{&ffi.TypePointer},
+}, func(_ *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoProbeCapability {
+	return func(info *opendalOperatorInfo, probe *capabilityProbe) {
+		ffiCall(
+			unsafe.Pointer(probe),
+			unsafe.Pointer(&info),
+		)
+	}
+})
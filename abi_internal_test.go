@@ -0,0 +1,43 @@
+package opendal
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newProbe returns a probe as if the C binding had written size bytes of valid
+// flags into it.
+func newProbe(size uintptr) *capabilityProbe {
+	probe := &capabilityProbe{}
+	b := unsafe.Slice((*byte)(unsafe.Pointer(probe)), unsafe.Sizeof(*probe))
+	for i := range b {
+		b[i] = capabilityGuard
+	}
+	for i := range size {
+		b[i] = 0
+	}
+	return probe
+}
+
+func TestCheckCapabilityProbe(t *testing.T) {
+	assert := require.New(t)
+
+	size := unsafe.Sizeof(opendalCapability{})
+	assert.Nil(checkCapabilityProbe(newProbe(size)))
+
+	err := checkCapabilityProbe(newProbe(size + 8))
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "larger than")
+
+	err = checkCapabilityProbe(newProbe(unsafe.Offsetof(opendalCapability{}.blocking)))
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "ends before blocking")
+
+	probe := newProbe(size)
+	probe.capability.write = 2
+	err = checkCapabilityProbe(probe)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "opendal_capability.write is 2")
+}
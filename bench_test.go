@@ -0,0 +1,172 @@
+package opendal_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.yuchanns.xyz/opendal"
+)
+
+// BenchmarkOperator measures the per-call overhead and allocations of the binding.
+// The benchmarks share one operator, since the library is removed once it is closed.
+//
+// Run it with: OPENDAL_TEST=memory go test -run '^$' -bench . -benchmem
+func BenchmarkOperator(b *testing.B) {
+	op, closeFunc, err := newOperator()
+	if err != nil {
+		b.Skip(err)
+	}
+	b.Cleanup(func() {
+		op.Close()
+		if closeFunc != nil {
+			closeFunc()
+		}
+	})
+
+	b.Run("Stat", func(b *testing.B) { benchmarkStat(b, op) })
+	b.Run("Read", func(b *testing.B) { benchmarkRead(b, op) })
+	b.Run("ReaderReadAt", func(b *testing.B) { benchmarkReaderReadAt(b, op) })
+	b.Run("ListerNext", func(b *testing.B) { benchmarkListerNext(b, op) })
+
+	for _, size := range []int{1 << 20, 16 << 20, 256 << 20, 1 << 30} {
+		b.Run(fmt.Sprintf("ReadLarge/%dMiB", size>>20), func(b *testing.B) { benchmarkReadLarge(b, op, size) })
+	}
+}
+
+func benchmarkStat(b *testing.B, op *opendal.Operator) {
+	path := uuid.NewString()
+	if err := op.Write(path, genFixedBytes(64)); err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { op.Delete(path) })
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := op.Stat(path); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkRead(b *testing.B, op *opendal.Operator) {
+	path := uuid.NewString()
+	if err := op.Write(path, genFixedBytes(64)); err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { op.Delete(path) })
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := op.Read(path); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkReaderReadAt(b *testing.B, op *opendal.Operator) {
+	path := uuid.NewString()
+	if err := op.Write(path, genFixedBytes(64)); err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { op.Delete(path) })
+
+	r, err := op.Reader(path)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer r.Close()
+	buf := make([]byte, 64)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := r.ReadAt(buf, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkListerNext(b *testing.B, op *opendal.Operator) {
+	parent := fmt.Sprintf("%s/", uuid.NewString())
+	for i := range 100 {
+		if err := op.Write(fmt.Sprintf("%s%d", parent, i), genFixedBytes(8)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.Cleanup(func() { op.RemoveAll(parent) })
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var lister *opendal.Lister
+	for range b.N {
+		if lister == nil {
+			b.StopTimer()
+			var err error
+			lister, err = op.List(parent)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.StartTimer()
+		}
+		if !lister.Next() {
+			if err := lister.Error(); err != nil {
+				b.Fatal(err)
+			}
+			lister.Close()
+			lister = nil
+		}
+	}
+	if lister != nil {
+		lister.Close()
+	}
+}
+
+// benchmarkReadLarge compares the allocations of Read, ReadInto and ReadPooled.
+// Objects of 1 GiB and above are only benchmarked if OPENDAL_BENCH_LARGE is set.
+func benchmarkReadLarge(b *testing.B, op *opendal.Operator, size int) {
+	if size >= 1<<30 && os.Getenv("OPENDAL_BENCH_LARGE") == "" {
+		b.Skip("set OPENDAL_BENCH_LARGE=1 to benchmark objects of 1 GiB and above")
+	}
+	path := uuid.NewString()
+	if err := op.Write(path, bytes.Repeat([]byte{'x'}, size)); err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { op.Delete(path) })
+
+	b.Run("Read", func(b *testing.B) {
+		b.SetBytes(int64(size))
+		b.ReportAllocs()
+		for range b.N {
+			if _, err := op.Read(path); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("ReadInto", func(b *testing.B) {
+		buf := make([]byte, size)
+		b.SetBytes(int64(size))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for range b.N {
+			if _, err := op.ReadInto(path, buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("ReadPooled", func(b *testing.B) {
+		b.SetBytes(int64(size))
+		b.ReportAllocs()
+		for range b.N {
+			buf, err := op.ReadPooled(path)
+			if err != nil {
+				b.Fatal(err)
+			}
+			buf.Release()
+		}
+	})
+}
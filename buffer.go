@@ -0,0 +1,216 @@
+package opendal
+
+import (
+	"context"
+	"io"
+	"math/bits"
+	"sync"
+)
+
+// ReadInto reads the contents of the file at the specified path into buf.
+//
+// Unlike Read, ReadInto does not allocate memory for the contents: the C binding
+// reads straight into buf, so the contents are never held twice in memory. The call
+// itself still makes a few small allocations, whatever the size of the file.
+//
+// # Parameters
+//
+//   - path: The path of the file to read.
+//   - buf: The buffer to read into.
+//
+// # Returns
+//
+//   - int: The number of bytes read into buf.
+//   - error: io.ErrShortBuffer if the file is larger than buf, in which case buf
+//     holds the first len(buf) bytes; an error if the read operation fails; or nil
+//     if successful.
+//
+// # Example
+//
+//	func exampleReadInto(op *opendal.Operator) {
+//		buf := make([]byte, 4096)
+//		n, err := op.ReadInto("test", buf)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("Read: %s\n", buf[:n])
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) ReadInto(path string, buf []byte) (int, error) {
+	return op.ReadIntoContext(context.Background(), path, buf)
+}
+
+// ReadIntoContext is like ReadInto but honors the deadline and cancellation of ctx.
+func (op *Operator) ReadIntoContext(ctx context.Context, path string, buf []byte) (n int, err error) {
+	err = op.intercept(ctx, &Call{Operation: "read_into", Path: path, Args: []any{buf}}, func(ctx context.Context) error {
+		r, err := op.readerWith(ctx, path, newReadOptions(nil))
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		n, err = r.readFull(buf)
+		if err != nil {
+			return err
+		}
+		if n < len(buf) {
+			return nil
+		}
+		var probe [1]byte
+		_, err = r.read(probe[:])
+		switch err {
+		case io.EOF:
+			return nil
+		case nil:
+			return io.ErrShortBuffer
+		default:
+			return err
+		}
+	})
+	return
+}
+
+// ReadPooled reads the entire contents of the file at the specified path into a
+// buffer borrowed from a pool.
+//
+// Like ReadInto, it reads straight into the buffer. The buffer is sized with Stat
+// and returned to the pool by Release, so that reading many files of similar sizes
+// does not allocate memory for their contents.
+//
+// # Parameters
+//
+//   - path: The path of the file to read.
+//
+// # Returns
+//
+//   - *PooledBuffer: The contents of the file. Call Release once done with it.
+//   - error: An error if the read operation fails, or nil if successful.
+//
+// # Example
+//
+//	func exampleReadPooled(op *opendal.Operator) {
+//		buf, err := op.ReadPooled("test")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		defer buf.Release()
+//		fmt.Printf("Read: %s\n", buf.Bytes())
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) ReadPooled(path string) (*PooledBuffer, error) {
+	return op.ReadPooledContext(context.Background(), path)
+}
+
+// ReadPooledContext is like ReadPooled but honors the deadline and cancellation of ctx.
+func (op *Operator) ReadPooledContext(ctx context.Context, path string) (*PooledBuffer, error) {
+	return interceptValue(op, ctx, &Call{Operation: "read_pooled", Path: path}, func(ctx context.Context) (*PooledBuffer, error) {
+		r, err := op.readerWith(ctx, path, newReadOptions(nil))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		size, err := r.Size()
+		if err != nil {
+			return nil, err
+		}
+
+		b := getPooledBuffer(int(size))
+		n, err := r.readFull(b.data)
+		if err != nil {
+			b.Release()
+			return nil, err
+		}
+		b.data = b.data[:n]
+		if n < int(size) {
+			return b, nil
+		}
+		// The file may have grown since Stat.
+		var probe [1]byte
+		_, err = r.read(probe[:])
+		if err == io.EOF {
+			return b, nil
+		}
+		if err != nil {
+			b.Release()
+			return nil, err
+		}
+		rest, err := r.readAll()
+		if err != nil {
+			b.Release()
+			return nil, err
+		}
+		b.data = append(append(b.data, probe[0]), rest...)
+		return b, nil
+	})
+}
+
+// PooledBuffer holds the contents of a file read by ReadPooled.
+type PooledBuffer struct {
+	data []byte
+	// pooled is what is put back into the pool on Release. It is nil if the
+	// buffer doesn't belong to a pool.
+	pooled *[]byte
+}
+
+// Bytes returns the contents of the file. The slice must not be used after Release.
+func (b *PooledBuffer) Bytes() []byte {
+	return b.data
+}
+
+// Release returns the buffer to the pool. Calling Release more than once has no effect.
+func (b *PooledBuffer) Release() {
+	if b.data == nil {
+		return
+	}
+	class, ok := bufferClass(cap(b.data))
+	// A buffer grown by append doesn't belong to a pool anymore.
+	if ok && b.pooled != nil && 1<<class == cap(b.data) {
+		*b.pooled = b.data[:0]
+		bufferPools[class].Put(b.pooled)
+	}
+	b.data, b.pooled = nil, nil
+}
+
+// minBufferClass is the smallest size class of pooled buffers, 4 KiB.
+const minBufferClass = 12
+
+// bufferPools holds pointers to the pooled buffers by size class, so that putting
+// a buffer back doesn't allocate. The buffers of class c have a capacity of exactly
+// 1<<c bytes.
+var bufferPools [bits.UintSize]sync.Pool
+
+// bufferClass returns the size class of a buffer of size bytes.
+func bufferClass(size int) (int, bool) {
+	class := max(bits.Len(uint(max(size, 1)-1)), minBufferClass)
+	return class, class < len(bufferPools)
+}
+
+func getPooledBuffer(size int) *PooledBuffer {
+	class, ok := bufferClass(size)
+	if !ok {
+		return &PooledBuffer{data: make([]byte, size)}
+	}
+	if pooled, ok := bufferPools[class].Get().(*[]byte); ok {
+		return &PooledBuffer{data: (*pooled)[:size], pooled: pooled}
+	}
+	data := make([]byte, size, 1<<class)
+	return &PooledBuffer{data: data, pooled: &data}
+}
+
+// readFull reads from the current offset until buf is full or the end of the file
+// or range is reached.
+func (r *OperatorReader) readFull(buf []byte) (int, error) {
+	var n int
+	for n < len(buf) {
+		size, err := r.read(buf[n:])
+		n += size
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
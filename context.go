@@ -0,0 +1,34 @@
+package opendal
+
+import (
+	"context"
+	"fmt"
+)
+
+// callContext runs call and returns its result, unless ctx is already done.
+//
+// A call into the C binding cannot be interrupted, so ctx is only checked before
+// the call starts. Once started, the call always runs to completion on the calling
+// goroutine and its result is returned even if ctx is done meanwhile: abandoning it
+// would let a cancelled write or delete take effect after the caller saw the error,
+// and would leave the call using the operator after Operator.Close.
+func callContext[T any](ctx context.Context, op string, call func() (T, error)) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, contextError(op, err)
+	}
+	return call()
+}
+
+// callContextErr is callContext for calls that only return an error.
+func callContextErr(ctx context.Context, op string, call func() error) error {
+	_, err := callContext(ctx, op, func() (struct{}, error) {
+		return struct{}{}, call()
+	})
+	return err
+}
+
+// contextError wraps the error of a done context with the operation name.
+func contextError(op string, err error) error {
+	return fmt.Errorf("opendal: %s: %w", op, err)
+}
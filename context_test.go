@@ -0,0 +1,155 @@
+package opendal_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsContext(cap *opendal.Capability) []behaviorTest {
+	if !cap.Read() || !cap.Write() || !cap.Stat() {
+		return nil
+	}
+	return []behaviorTest{
+		testContextBackground,
+		testContextCancelled,
+		testContextDeadlineExceeded,
+		testContextReaderCancelled,
+		testContextListerCancelled,
+		testContextCancelledInFlight,
+	}
+}
+
+func testContextBackground(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFile()
+	ctx := context.Background()
+
+	assert.Nil(op.WriteContext(ctx, path, content), "write must succeed")
+
+	meta, err := op.StatContext(ctx, path)
+	assert.Nil(err)
+	assert.Equal(uint64(size), meta.ContentLength())
+
+	bs, err := op.ReadContext(ctx, path)
+	assert.Nil(err)
+	assert.Equal(content, bs)
+}
+
+func testContextCancelled(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := op.WriteContext(ctx, path, content)
+	assert.True(errors.Is(err, context.Canceled), "write must be cancelled: %v", err)
+
+	exist, err := op.IsExist(path)
+	assert.Nil(err)
+	assert.False(exist, "cancelled write must not be issued")
+
+	_, err = op.ReadContext(ctx, path)
+	assert.True(errors.Is(err, context.Canceled), "read must be cancelled: %v", err)
+
+	_, err = op.StatContext(ctx, path)
+	assert.True(errors.Is(err, context.Canceled), "stat must be cancelled: %v", err)
+
+	_, err = op.ListContext(ctx, "/")
+	assert.True(errors.Is(err, context.Canceled), "list must be cancelled: %v", err)
+
+	err = op.DeleteContext(ctx, path)
+	assert.True(errors.Is(err, context.Canceled), "delete must be cancelled: %v", err)
+}
+
+func testContextDeadlineExceeded(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	_, err := op.ReaderContext(ctx, path)
+	assert.True(errors.Is(err, context.DeadlineExceeded), "reader must time out: %v", err)
+
+	err = op.CreateDirContext(ctx, fmt.Sprintf("%s/", path))
+	assert.True(errors.Is(err, context.DeadlineExceeded), "create dir must time out: %v", err)
+}
+
+func testContextReaderCancelled(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFileWithRange(uuid.NewString(), 64, 1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := op.ReaderContext(ctx, path)
+	assert.Nil(err)
+	defer r.Close()
+
+	bs := make([]byte, 16)
+	n, err := r.Read(bs)
+	assert.Nil(err)
+	assert.Equal(content[:n], bs[:n])
+
+	cancel()
+
+	_, err = r.Read(bs)
+	assert.True(errors.Is(err, context.Canceled), "read must stop once cancelled: %v", err)
+}
+
+func testContextListerCancelled(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	if !op.Info().GetFullCapability().List() {
+		return
+	}
+
+	parent := fixture.NewDirPath()
+	for range 3 {
+		path, content, _ := fixture.NewFileWithRange(fmt.Sprintf("%s%s", parent, uuid.NewString()), 1, 16)
+		assert.Nil(op.Write(path, content), "write must succeed")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obs, err := op.ListContext(ctx, parent)
+	assert.Nil(err)
+	defer obs.Close()
+
+	assert.True(obs.Next())
+	cancel()
+	assert.False(obs.Next(), "lister must stop once cancelled")
+	assert.True(errors.Is(obs.Error(), context.Canceled), "lister error must wrap ctx.Err(): %v", obs.Error())
+}
+
+func testContextCancelledInFlight(assert *require.Assertions, _ *opendal.Operator, fixture *fixture) {
+	scheme := testScheme()
+	other, err := opendal.NewOperator(scheme, envOptions(scheme))
+	assert.Nil(err, "create operator must succeed")
+
+	path := fixture.NewFilePath()
+	content := genFixedBytes(32 << 20)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	time.AfterFunc(time.Millisecond, cancel)
+
+	// The write is either rejected before it starts or runs to completion; it is
+	// never left running once WriteContext returns, so closing right after is safe.
+	err = other.WriteContext(ctx, path, content)
+	if err != nil {
+		assert.True(errors.Is(err, context.Canceled), "write must be cancelled: %v", err)
+		exist, err := other.IsExist(path)
+		assert.Nil(err)
+		assert.False(exist, "cancelled write must not be issued")
+	} else {
+		meta, err := other.Stat(path)
+		assert.Nil(err)
+		assert.Equal(uint64(len(content)), meta.ContentLength(), "completed write must not be cut short")
+	}
+	other.Close()
+}
@@ -2,6 +2,11 @@ package opendal
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
@@ -22,8 +27,238 @@ import (
 //
 // Use with caution as this operation is irreversible.
 func (op *Operator) Delete(path string) error {
-	delete := getFFI[operatorDelete](op.ctx, symOperatorDelete)
-	return delete(op.inner, path)
+	return op.DeleteContext(context.Background(), path)
+}
+
+// DeleteContext is like Delete but honors the deadline and cancellation of ctx.
+//
+// If ctx is done before the deletion starts, DeleteContext returns an error wrapping
+// ctx.Err(). Once started, the deletion cannot be aborted and runs to completion.
+func (op *Operator) DeleteContext(ctx context.Context, path string) error {
+	return op.intercept(ctx, &Call{Operation: "delete", Path: path}, func(ctx context.Context) error {
+		delete := op.syms.operatorDelete
+		if delete == nil {
+			return errUnsupported(symOperatorDelete)
+		}
+		return callContextErr(ctx, "delete", func() error {
+			return delete(op.inner, path)
+		})
+	})
+}
+
+// DeleteMany removes the files or directories at the specified paths.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_delete_many`.
+// Every failure is collected instead of stopping at the first.
+//
+// # Parameters
+//
+//   - paths: The paths of the files or directories to delete.
+//
+// # Returns
+//
+//   - error: A *DeleteError listing every path that could not be deleted, or nil if all
+//     deletions succeed.
+//
+// # Notes
+//
+//   - Deleting a path that does not exist is not an error.
+//   - If Capability.BatchDelete is set, paths are deleted in batches of at most
+//     Capability.BatchMaxOperations paths. Otherwise, or if the loaded C binding does not
+//     export `opendal_operator_delete_many`, paths are deleted one by one with
+//     `opendal_operator_delete`.
+//
+// # Example
+//
+//	func exampleDeleteMany(op *opendal.Operator) {
+//		err := op.DeleteMany([]string{"path/to/a", "path/to/b"})
+//		var e *opendal.DeleteError
+//		if errors.As(err, &e) {
+//			for _, failure := range e.Failures {
+//				fmt.Printf("Failed to delete %s: %v\n", failure.Path, failure.Err)
+//			}
+//		}
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) DeleteMany(paths []string) error {
+	return op.DeleteManyContext(context.Background(), paths)
+}
+
+// DeleteManyContext is like DeleteMany but honors the deadline and cancellation of ctx.
+//
+// Paths that have not been sent for deletion when ctx is done are reported as failures
+// with an error wrapping ctx.Err().
+func (op *Operator) DeleteManyContext(ctx context.Context, paths []string) error {
+	return op.intercept(ctx, &Call{Operation: "delete_many", Args: []any{paths}}, func(ctx context.Context) error {
+		deleteMany := op.syms.operatorDeleteMany
+		batchSize := 1
+		if cap := op.Info().GetFullCapability(); deleteMany != nil && cap.BatchDelete() {
+			batchSize = len(paths)
+			if limit := int(cap.BatchMaxOperations()); limit > 0 && limit < batchSize {
+				batchSize = limit
+			}
+		}
+
+		var failures []DeleteFailure
+		for start := 0; start < len(paths); start += batchSize {
+			if err := ctx.Err(); err != nil {
+				err = contextError("delete", err)
+				for _, path := range paths[start:] {
+					failures = append(failures, DeleteFailure{Path: path, Err: err})
+				}
+				break
+			}
+			chunk := paths[start:min(start+batchSize, len(paths))]
+			if batchSize == 1 {
+				if err := op.DeleteContext(ctx, chunk[0]); err != nil {
+					failures = append(failures, DeleteFailure{Path: chunk[0], Err: err})
+				}
+				continue
+			}
+			errs, err := callContext(ctx, "delete", func() ([]error, error) {
+				return deleteMany(op.inner, chunk)
+			})
+			for i, path := range chunk {
+				if err != nil {
+					failures = append(failures, DeleteFailure{Path: path, Err: err})
+				} else if errs[i] != nil && !errors.Is(errs[i], ErrNotFound) {
+					failures = append(failures, DeleteFailure{Path: path, Err: annotate(errs[i], &Call{Operation: "delete", Path: path})})
+				}
+			}
+		}
+
+		if len(failures) > 0 {
+			return &DeleteError{Failures: failures}
+		}
+		return nil
+	})
+}
+
+// RemoveAll removes path and everything under it.
+//
+// The tree is listed recursively and deleted with DeleteMany, files first and then
+// directories from the deepest level up. If path does not exist, RemoveAll returns nil.
+//
+// # Parameters
+//
+//   - path: The path of the file or directory to remove.
+//
+// # Returns
+//
+//   - error: A *DeleteError listing every path that could not be deleted, any other error
+//     if listing fails, or nil if successful.
+//
+// # Note
+//
+// Use with caution as this operation is irreversible.
+func (op *Operator) RemoveAll(path string) error {
+	return op.RemoveAllContext(context.Background(), path)
+}
+
+// RemoveAllContext is like RemoveAll but honors the deadline and cancellation of ctx.
+func (op *Operator) RemoveAllContext(ctx context.Context, path string) error {
+	return op.intercept(ctx, &Call{Operation: "remove_all", Path: path}, func(ctx context.Context) error {
+		if !strings.HasSuffix(path, "/") {
+			meta, err := op.StatContext(ctx, path)
+			if err != nil {
+				if errors.Is(err, ErrNotFound) {
+					return nil
+				}
+				return err
+			}
+			if !meta.IsDir() {
+				return op.DeleteManyContext(ctx, []string{path})
+			}
+			path += "/"
+		}
+
+		lister, err := op.ListWithContext(ctx, path, ListRecursive())
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		defer lister.Close()
+
+		var files []string
+		// dirs groups directories by their depth, so that children are deleted
+		// before their parents.
+		dirs := map[int][]string{}
+		seen := map[string]bool{path: true}
+		for lister.Next() {
+			entryPath := lister.Entry().Path()
+			if seen[entryPath] {
+				continue
+			}
+			seen[entryPath] = true
+			if strings.HasSuffix(entryPath, "/") {
+				depth := strings.Count(entryPath, "/")
+				dirs[depth] = append(dirs[depth], entryPath)
+			} else {
+				files = append(files, entryPath)
+			}
+		}
+		if err := lister.Error(); err != nil {
+			return err
+		}
+		if path != "/" {
+			depth := strings.Count(path, "/")
+			dirs[depth] = append(dirs[depth], path)
+		}
+
+		var failures []DeleteFailure
+		collect := func(err error) {
+			var e *DeleteError
+			if errors.As(err, &e) {
+				failures = append(failures, e.Failures...)
+			}
+		}
+		collect(op.DeleteManyContext(ctx, files))
+		depths := make([]int, 0, len(dirs))
+		for depth := range dirs {
+			depths = append(depths, depth)
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(depths)))
+		for _, depth := range depths {
+			collect(op.DeleteManyContext(ctx, dirs[depth]))
+		}
+
+		if len(failures) > 0 {
+			return &DeleteError{Failures: failures}
+		}
+		return nil
+	})
+}
+
+// DeleteError is returned by DeleteMany and RemoveAll when some paths could not be deleted.
+type DeleteError struct {
+	// Failures lists the paths that could not be deleted, in the order they were attempted.
+	Failures []DeleteFailure
+}
+
+// DeleteFailure records why a path could not be deleted.
+type DeleteFailure struct {
+	Path string
+	Err  error
+}
+
+func (e *DeleteError) Error() string {
+	if len(e.Failures) == 1 {
+		return fmt.Sprintf("failed to delete %s: %v", e.Failures[0].Path, e.Failures[0].Err)
+	}
+	return fmt.Sprintf("failed to delete %d paths, first %s: %v",
+		len(e.Failures), e.Failures[0].Path, e.Failures[0].Err)
+}
+
+// Unwrap returns the errors of all failures, for use with errors.Is and errors.As.
+func (e *DeleteError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure.Err
+	}
+	return errs
 }
 
 type operatorDelete func(op *opendalOperator, path string) error
@@ -31,10 +266,11 @@ type operatorDelete func(op *opendalOperator, path string) error
 const symOperatorDelete = "opendal_operator_delete"
 
 var withOperatorDelete = withFFI(ffiOpts{
-	sym:    symOperatorDelete,
-	rType:  &ffi.TypePointer,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorDelete {
+	sym:      symOperatorDelete,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorDelete {
 	return func(op *opendalOperator, path string) error {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -46,6 +282,60 @@ var withOperatorDelete = withFFI(ffiOpts{
 			unsafe.Pointer(&op),
 			unsafe.Pointer(&bytePath),
 		)
-		return parseError(ctx, e)
+		return parseError(syms, e)
+	}
+})
+
+const symOperatorDeleteMany = "opendal_operator_delete_many"
+
+// operatorDeleteMany returns the error of each path, or a single error if the
+// whole batch fails.
+type operatorDeleteMany func(op *opendalOperator, paths []string) ([]error, error)
+
+var withOperatorDeleteMany = withFFI(ffiOpts{
+	sym:      symOperatorDeleteMany,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypeUint64, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorDeleteMany {
+	return func(op *opendalOperator, paths []string) ([]error, error) {
+		if len(paths) == 0 {
+			return nil, nil
+		}
+		// The C binding reads the array of paths, which points to Go memory, so both
+		// are pinned for the duration of the call.
+		var pinner runtime.Pinner
+		defer pinner.Unpin()
+		bytePaths := make([]*byte, len(paths))
+		for i, path := range paths {
+			bytePath, err := unix.BytePtrFromString(path)
+			if err != nil {
+				return nil, err
+			}
+			pinner.Pin(bytePath)
+			bytePaths[i] = bytePath
+		}
+		results := make([]*opendalError, len(paths))
+		pathsPtr := &bytePaths[0]
+		resultsPtr := &results[0]
+		pinner.Pin(pathsPtr)
+		pinner.Pin(resultsPtr)
+		length := uint64(len(paths))
+		var e *opendalError
+		ffiCall(
+			unsafe.Pointer(&e),
+			unsafe.Pointer(&op),
+			unsafe.Pointer(&pathsPtr),
+			unsafe.Pointer(&length),
+			unsafe.Pointer(&resultsPtr),
+		)
+		if err := parseError(syms, e); err != nil {
+			return nil, err
+		}
+		errs := make([]error, len(paths))
+		for i, result := range results {
+			errs[i] = parseError(syms, result)
+		}
+		return errs, nil
 	}
 })
@@ -1,6 +1,8 @@
 package opendal_test
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.yuchanns.xyz/opendal"
@@ -15,6 +17,13 @@ func testsDelete(cap *opendal.Capability) []behaviorTest {
 		testDeleteEmptyDir,
 		testDeleteWithSpecialChars,
 		testDeleteNotExisting,
+		testDeleteMany,
+	}
+	if cap.BatchDelete() {
+		tests = append(tests, testDeleteManyBatches)
+	}
+	if cap.List() && cap.ListWithRecursive() {
+		tests = append(tests, testRemoveAll, testRemoveAllFile, testRemoveAllNotExisting)
 	}
 	return tests
 }
@@ -57,3 +66,74 @@ func testDeleteNotExisting(assert *require.Assertions, op *opendal.Operator, fix
 
 	assert.Nil(op.Delete(path))
 }
+
+func testDeleteMany(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	var paths []string
+	for range 5 {
+		path, content, _ := fixture.NewFile()
+		assert.Nil(op.Write(path, content), "write must succeed")
+		paths = append(paths, path)
+	}
+	paths = append(paths, uuid.NewString())
+
+	assert.Nil(op.DeleteMany(paths))
+
+	for _, path := range paths {
+		assert.False(op.IsExist(path))
+	}
+}
+
+func testDeleteManyBatches(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	// One more path than fits in a batch, so that the paths are sent in two batches.
+	size := int(op.Info().GetFullCapability().BatchMaxOperations())
+	if size <= 0 || size > 100 {
+		size = 100
+	}
+	var paths []string
+	for range size + 1 {
+		path, content, _ := fixture.NewFileWithRange(fixture.NewFilePath(), 1, 16)
+		assert.Nil(op.Write(path, content), "write must succeed")
+		paths = append(paths, path)
+	}
+	paths = append(paths, fixture.NewFilePath())
+
+	assert.Nil(op.DeleteMany(paths), "a path that does not exist must not fail the batch")
+	for _, path := range paths {
+		exist, err := op.IsExist(path)
+		assert.Nil(err)
+		assert.False(exist, "%s must be deleted", path)
+	}
+}
+
+func testRemoveAll(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+	paths := []string{
+		fmt.Sprintf("%sa", parent),
+		fmt.Sprintf("%sb/c", parent),
+		fmt.Sprintf("%sb/d/e", parent),
+	}
+	for _, path := range paths {
+		_, content, _ := fixture.NewFileWithPath(path)
+		assert.Nil(op.Write(path, content), "write must succeed")
+	}
+
+	assert.Nil(op.RemoveAll(parent))
+
+	for _, path := range paths {
+		assert.False(op.IsExist(path))
+	}
+}
+
+func testRemoveAllFile(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	assert.Nil(op.RemoveAll(path))
+
+	assert.False(op.IsExist(path))
+}
+
+func testRemoveAllNotExisting(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	assert.Nil(op.RemoveAll(uuid.NewString() + "/"))
+}
@@ -1,8 +1,11 @@
 package opendal
 
 import (
-	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
@@ -50,25 +53,156 @@ const (
 	CodeRangeNotSatisfied
 )
 
-func parseError(ctx context.Context, err *opendalError) error {
+var errorCodeNames = [...]string{
+	CodeUnexpected:        "Unexpected",
+	CodeUnsupported:       "Unsupported",
+	CodeConfigInvalid:     "ConfigInvalid",
+	CodeNotFound:          "NotFound",
+	CodePermissioDenied:   "PermissionDenied",
+	CodeIsADirectory:      "IsADirectory",
+	CodeNotADirectory:     "NotADirectory",
+	CodeAlreadyExists:     "AlreadyExists",
+	CodeRateLimited:       "RateLimited",
+	CodeIsSameFile:        "IsSameFile",
+	CodeConditionNotMatch: "ConditionNotMatch",
+	CodeRangeNotSatisfied: "RangeNotSatisfied",
+}
+
+// String returns the name of the code as reported by OpenDAL, such as "NotFound".
+func (c ErrorCode) String() string {
+	if c >= 0 && int(c) < len(errorCodeNames) {
+		return errorCodeNames[c]
+	}
+	return fmt.Sprintf("ErrorCode(%d)", int32(c))
+}
+
+// Sentinel errors matching any *Error with the same code, to be used with errors.Is.
+//
+// # Example
+//
+//	_, err := op.Stat("path/to/file")
+//	if errors.Is(err, opendal.ErrNotFound) {
+//		fmt.Println("The file does not exist")
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+var (
+	ErrUnexpected        error = newSentinel(CodeUnexpected)
+	ErrUnsupported       error = newSentinel(CodeUnsupported)
+	ErrConfigInvalid     error = newSentinel(CodeConfigInvalid)
+	ErrNotFound          error = newSentinel(CodeNotFound)
+	ErrPermissionDenied  error = newSentinel(CodePermissioDenied)
+	ErrIsADirectory      error = newSentinel(CodeIsADirectory)
+	ErrNotADirectory     error = newSentinel(CodeNotADirectory)
+	ErrAlreadyExists     error = newSentinel(CodeAlreadyExists)
+	ErrRateLimited       error = newSentinel(CodeRateLimited)
+	ErrIsSameFile        error = newSentinel(CodeIsSameFile)
+	ErrConditionNotMatch error = newSentinel(CodeConditionNotMatch)
+	ErrRangeNotSatisfied error = newSentinel(CodeRangeNotSatisfied)
+)
+
+func newSentinel(code ErrorCode) *Error {
+	return &Error{code: code, message: code.String()}
+}
+
+func parseError(syms *symbols, err *opendalError) error {
 	if err == nil {
 		return nil
 	}
-	free := getFFI[errorFree](ctx, symErrorFree)
+	free := syms.errorFree
 	defer free(err)
+	code := ErrorCode(err.code)
 	return &Error{
-		code:    ErrorCode(err.code),
+		code:    code,
 		message: string(parseBytes(&err.message)),
+		// The C binding doesn't expose the temporary flag of OpenDAL, and an
+		// unexpected error may as well be persistent.
+		temporary: code == CodeRateLimited,
+	}
+}
+
+// annotate returns a copy of err carrying the operation and paths of call, if err
+// is an *Error that doesn't carry them yet. err itself is never modified, as it may
+// be shared, such as a sentinel error returned by an interceptor.
+func annotate(err error, call *Call) error {
+	e, ok := err.(*Error)
+	if !ok || e.operation != "" {
+		return err
 	}
+	annotated := *e
+	annotated.operation = call.Operation
+	annotated.paths = slices.Clone(e.paths)
+	if call.Path != "" {
+		annotated.paths = append(annotated.paths, call.Path)
+	}
+	for _, arg := range call.Args {
+		if path, ok := arg.(string); ok {
+			annotated.paths = append(annotated.paths, path)
+		}
+	}
+	return &annotated
 }
 
+// errUnsupported reports that the loaded C binding does not export sym.
+func errUnsupported(sym string) error {
+	return &Error{
+		code:    CodeUnsupported,
+		message: fmt.Sprintf("%s is not exported by the loaded C binding", sym),
+	}
+}
+
+// Error is an error reported by OpenDAL.
+//
+// Use errors.Is with the sentinel errors such as ErrNotFound to check its code.
+// Errors with code CodeNotFound, CodeAlreadyExists, CodePermissioDenied and
+// CodeUnsupported also match fs.ErrNotExist, fs.ErrExist, fs.ErrPermission and
+// errors.ErrUnsupported respectively.
 type Error struct {
-	code    ErrorCode
-	message string
+	code      ErrorCode
+	message   string
+	temporary bool
+
+	operation string
+	paths     []string
 }
 
 func (e *Error) Error() string {
-	return fmt.Sprintf("%d %s", e.code, e.message)
+	var b strings.Builder
+	b.WriteString("opendal: ")
+	if e.operation != "" {
+		b.WriteString(e.operation)
+		for _, path := range e.paths {
+			b.WriteString(" ")
+			b.WriteString(path)
+		}
+		b.WriteString(": ")
+	}
+	// Messages from OpenDAL already start with the name of the code.
+	if !strings.HasPrefix(e.message, e.code.String()) {
+		b.WriteString(e.code.String())
+		b.WriteString(": ")
+	}
+	b.WriteString(e.message)
+	return b.String()
+}
+
+// Is reports whether target is an *Error with the same code as e, such as the
+// sentinel error of the code, or the matching error of the io/fs package.
+func (e *Error) Is(target error) bool {
+	if t, ok := target.(*Error); ok {
+		return t.code == e.code
+	}
+	switch target {
+	case fs.ErrNotExist:
+		return e.code == CodeNotFound
+	case fs.ErrExist:
+		return e.code == CodeAlreadyExists
+	case fs.ErrPermission:
+		return e.code == CodePermissioDenied
+	case errors.ErrUnsupported:
+		return e.code == CodeUnsupported
+	}
+	return false
 }
 
 func (e *Error) Code() ErrorCode {
@@ -79,6 +213,27 @@ func (e *Error) Message() string {
 	return e.message
 }
 
+// Operation returns the name of the operation that failed, such as "stat" or
+// "reader.read". It is the Operation of the Call seen by interceptors.
+func (e *Error) Operation() string {
+	return e.operation
+}
+
+// Paths returns the paths the failed operation was called with. Copy and Rename
+// report both the source and the destination.
+func (e *Error) Paths() []string {
+	return e.paths
+}
+
+// Temporary reports whether the error is transient, so that retrying the same
+// operation later may succeed. Errors that are not temporary are persistent.
+//
+// Only errors with code CodeRateLimited are considered temporary, as the C binding
+// doesn't report whether OpenDAL would retry an error.
+func (e *Error) Temporary() bool {
+	return e.temporary
+}
+
 type errorFree func(e *opendalError)
 
 const symErrorFree = "opendal_error_free"
@@ -87,7 +242,7 @@ var withErrorFree = withFFI(ffiOpts{
 	sym:    symErrorFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(_ context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) errorFree {
+}, func(_ *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) errorFree {
 	return func(e *opendalError) {
 		ffiCall(
 			nil,
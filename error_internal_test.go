@@ -0,0 +1,22 @@
+package opendal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrorTemporary(t *testing.T) {
+	assert := require.New(t)
+
+	syms := (&fakeFile{}).symbols()
+	for code, temporary := range map[ErrorCode]bool{
+		CodeRateLimited: true,
+		CodeUnexpected:  false,
+		CodeNotFound:    false,
+	} {
+		err := parseError(syms, &opendalError{code: int32(code)})
+		assert.Equal(code, err.(*Error).Code())
+		assert.Equal(temporary, err.(*Error).Temporary(), "temporary flag of %s", code)
+	}
+}
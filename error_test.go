@@ -0,0 +1,83 @@
+package opendal_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsError(cap *opendal.Capability) []behaviorTest {
+	if !cap.Stat() || !cap.Write() {
+		return nil
+	}
+	return []behaviorTest{
+		testErrorIsNotFound,
+		testErrorPaths,
+		testErrorSentinelFromInterceptor,
+	}
+}
+
+func testErrorIsNotFound(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+
+	_, err := op.Stat(path)
+	assert.NotNil(err)
+	assert.True(errors.Is(err, opendal.ErrNotFound), "stat must fail with ErrNotFound: %v", err)
+	assert.True(errors.Is(err, fs.ErrNotExist), "stat must fail with fs.ErrNotExist: %v", err)
+	assert.False(errors.Is(err, opendal.ErrAlreadyExists))
+	assert.False(errors.Is(err, fs.ErrExist))
+
+	var e *opendal.Error
+	assert.True(errors.As(err, &e))
+	assert.Equal("stat", e.Operation())
+	assert.Equal([]string{path}, e.Paths())
+	assert.Contains(err.Error(), "stat "+path)
+}
+
+func testErrorPaths(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	if !op.Info().GetFullCapability().Copy() {
+		return
+	}
+	src, dest := fixture.NewFilePath(), fixture.NewFilePath()
+
+	err := op.Copy(src, dest)
+	assert.NotNil(err)
+
+	var e *opendal.Error
+	assert.True(errors.As(err, &e))
+	assert.Equal("copy", e.Operation())
+	assert.Equal([]string{src, dest}, e.Paths())
+}
+
+func testErrorSentinelFromInterceptor(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+	layered := op.Layer(func(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+		return opendal.ErrNotFound
+	})
+
+	_, err := layered.Stat(path)
+	assert.ErrorIs(err, opendal.ErrNotFound)
+	var e *opendal.Error
+	assert.True(errors.As(err, &e))
+	assert.Equal("stat", e.Operation())
+	assert.Equal([]string{path}, e.Paths())
+
+	var sentinel *opendal.Error
+	assert.True(errors.As(opendal.ErrNotFound, &sentinel))
+	assert.Empty(sentinel.Operation(), "the sentinel error must not be modified")
+	assert.Empty(sentinel.Paths())
+}
+
+func TestErrorCodeString(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal("NotFound", opendal.CodeNotFound.String())
+	assert.Equal("PermissionDenied", opendal.CodePermissioDenied.String())
+	assert.Equal("RangeNotSatisfied", opendal.CodeRangeNotSatisfied.String())
+	assert.Equal("ErrorCode(42)", opendal.ErrorCode(42).String())
+	assert.Equal("opendal: NotFound", opendal.ErrNotFound.Error())
+}
@@ -0,0 +1,96 @@
+package opendal
+
+import (
+	"sync"
+)
+
+// fakeFile is a single file served through a fake symbol table, standing in for
+// the C binding where the bundled libraries don't export the symbols under test.
+// It counts the calls and bytes that reach the fake binding.
+type fakeFile struct {
+	content []byte
+	pos     int
+	// lastModified is in milliseconds since the epoch, or 0 if unknown.
+	lastModified int64
+	seekable     bool
+	// failAt makes the next read from this position fail once with a temporary
+	// error, if it is positive.
+	failAt int
+
+	etag         *string
+	contentType  *string
+	version      *string
+	userMetadata [][2]string
+
+	method  string
+	uri     string
+	headers [][2]string
+
+	stats     int
+	readers   int
+	bytesRead int
+	freed     int
+}
+
+func (f *fakeFile) symbols() *symbols {
+	syms := &symbols{
+		errorFree: func(*opendalError) {},
+
+		operatorStat: func(*opendalOperator, string) (*opendalMetadata, error) {
+			f.stats++
+			return &opendalMetadata{}, nil
+		},
+		metadataContentLength: func(*opendalMetadata) uint64 { return uint64(len(f.content)) },
+		metadataIsFile:        func(*opendalMetadata) bool { return true },
+		metadataIsDir:         func(*opendalMetadata) bool { return false },
+		metadataLastModified: func(*opendalMetadata) int64 {
+			if f.lastModified == 0 {
+				return -1
+			}
+			return f.lastModified
+		},
+		metadataETag:              func(*opendalMetadata) *string { return f.etag },
+		metadataContentType:       func(*opendalMetadata) *string { return f.contentType },
+		metadataVersion:           func(*opendalMetadata) *string { return f.version },
+		metadataUserMetadataLen:   func(*opendalMetadata) uint64 { return uint64(len(f.userMetadata)) },
+		metadataUserMetadataKey:   func(_ *opendalMetadata, i uint64) string { return f.userMetadata[i][0] },
+		metadataUserMetadataValue: func(_ *opendalMetadata, i uint64) string { return f.userMetadata[i][1] },
+		metadataFree:              func(*opendalMetadata) { f.freed++ },
+
+		operatorReader: func(*opendalOperator, string) (*opendalReader, error) {
+			f.pos = 0
+			f.readers++
+			return &opendalReader{}, nil
+		},
+		readerRead: func(_ *opendalReader, buf []byte) (uint, error) {
+			if f.failAt > 0 && f.pos >= f.failAt {
+				f.failAt = 0
+				return 0, &Error{code: CodeRateLimited, message: "slow down", temporary: true}
+			}
+			n := copy(buf, f.content[f.pos:])
+			f.pos += n
+			f.bytesRead += n
+			return uint(n), nil
+		},
+		readerFree: func(*opendalReader) {},
+
+		presignedRequestMethod:      func(*opendalPresignedRequest) string { return f.method },
+		presignedRequestURI:         func(*opendalPresignedRequest) string { return f.uri },
+		presignedRequestHeadersLen:  func(*opendalPresignedRequest) uint64 { return uint64(len(f.headers)) },
+		presignedRequestHeaderKey:   func(_ *opendalPresignedRequest, i uint64) string { return f.headers[i][0] },
+		presignedRequestHeaderValue: func(_ *opendalPresignedRequest, i uint64) string { return f.headers[i][1] },
+		presignedRequestFree:        func(*opendalPresignedRequest) { f.freed++ },
+	}
+	if f.seekable {
+		syms.readerSeek = func(_ *opendalReader, offset int64, _ int) (uint64, error) {
+			f.pos = int(offset)
+			return uint64(offset), nil
+		}
+	}
+	return syms
+}
+
+// operator returns an Operator whose calls reach the fake binding of f.
+func (f *fakeFile) operator() *Operator {
+	return &Operator{syms: f.symbols(), closeOnce: &sync.Once{}}
+}
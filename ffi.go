@@ -1,52 +1,276 @@
 package opendal
 
 import (
-	"context"
 	"errors"
+	"sync"
 	"unsafe"
 
 	"github.com/ebitengine/purego"
 	"github.com/jupiterrider/ffi"
 )
 
-func contextWithFFIs(path string) (ctx context.Context, cancel context.CancelFunc, err error) {
-	libopendal, err := purego.Dlopen(path, purego.RTLD_LAZY|purego.RTLD_GLOBAL)
-	if err != nil {
-		return
-	}
-	ctx = context.Background()
-	for _, withFFI := range withFFIs {
-		ctx, err = withFFI(ctx, libopendal)
+// loadSymbols returns the symbol table of the library at path. The library is
+// loaded and its symbols are resolved by the first call only; later calls share them
+// until every returned cancel has been called, which unloads the library.
+func loadSymbols(path string) (syms *symbols, cancel func(), err error) {
+	libraries.Lock()
+	defer libraries.Unlock()
+
+	lib, ok := libraries.loaded[path]
+	if !ok {
+		lib, err = loadLibrary(path)
 		if err != nil {
 			return
 		}
+		libraries.loaded[path] = lib
 	}
+	lib.refs++
+
+	var once sync.Once
 	cancel = func() {
+		once.Do(func() {
+			libraries.Lock()
+			defer libraries.Unlock()
+			lib.refs--
+			if lib.refs == 0 {
+				delete(libraries.loaded, path)
+				purego.Dlclose(lib.handle)
+			}
+		})
+	}
+	return lib.syms, cancel, nil
+}
+
+// libraries holds the loaded libraries by path.
+var libraries = struct {
+	sync.Mutex
+	loaded map[string]*library
+}{
+	loaded: map[string]*library{},
+}
+
+type library struct {
+	handle uintptr
+	syms   *symbols
+	// refs is the number of operators using the library.
+	refs int
+}
+
+func loadLibrary(path string) (*library, error) {
+	// Every service library exports the same opendal_* symbols. RTLD_LOCAL keeps
+	// them out of the global namespace, so that loading several schemes never binds
+	// a call to the symbol of another library.
+	libopendal, err := purego.Dlopen(path, purego.RTLD_LAZY|purego.RTLD_LOCAL)
+	if err != nil {
+		return nil, err
+	}
+	syms, err := resolveSymbols(libopendal)
+	if err != nil {
 		purego.Dlclose(libopendal)
+		return nil, errIncompatible(path, err.Error())
 	}
-	return
+	return &library{handle: libopendal, syms: syms}, nil
+}
+
+// symbols holds the functions of a loaded library as typed fields, so that a call
+// costs no more than a field access once the library is loaded.
+//
+// Optional symbols that are not exported by the loaded C binding are left nil.
+type symbols struct {
+	bytesFree bytesFree
+	errorFree errorFree
+
+	operatorOptionsNew  operatorOptionsNew
+	operatorOptionSet   operatorOptionsSet
+	operatorOptionsFree operatorOptionsFree
+
+	operatorNew  operatorNew
+	operatorFree operatorFree
+
+	operatorInfoNew                 operatorInfoNew
+	operatorInfoGetFullCapability   operatorInfoGetFullCapability
+	operatorInfoGetNativeCapability operatorInfoGetNativeCapability
+	operatorInfoProbeCapability     operatorInfoProbeCapability
+	operatorInfoGetScheme           operatorInfoGetScheme
+	operatorInfoGetRoot             operatorInfoGetRoot
+	operatorInfoGetName             operatorInfoGetName
+	operatorInfoFree                operatorInfoFree
+
+	operatorCreateDir  operatorCreateDir
+	operatorRead       operatorRead
+	operatorWrite      operatorWrite
+	operatorWriteWith  operatorWriteWith
+	operatorDelete     operatorDelete
+	operatorDeleteMany operatorDeleteMany
+	operatorStat       operatorStat
+	operatorStatWith   operatorStatWith
+	operatorIsExist    operatorIsExist
+	operatorCopy       operatorCopy
+	operatorRename     operatorRename
+
+	metadataContentLength      metaContentLength
+	metadataIsFile             metaIsFile
+	metadataIsDir              metaIsDir
+	metadataLastModified       metaLastModified
+	metadataETag               metaString
+	metadataContentType        metaString
+	metadataContentMD5         metaString
+	metadataContentDisposition metaString
+	metadataCacheControl       metaString
+	metadataVersion            metaString
+	metadataUserMetadataLen    metaUserMetadataLen
+	metadataUserMetadataKey    metaUserMetadataAt
+	metadataUserMetadataValue  metaUserMetadataAt
+	metadataFree               metaFree
+
+	operatorList  operatorList
+	listerNext    listerNext
+	listerFree    listerFree
+	entryName     entryName
+	entryPath     entryPath
+	entryMetadata entryMetadata
+	entryFree     entryFree
+
+	operatorReader operatorReader
+	readerRead     readerRead
+	readerFree     readerFree
+	readerSeek     readerSeek
+
+	operatorWriter     operatorWriter
+	operatorWriterWith operatorWriterWith
+	writerWrite        writerWrite
+	writerClose        writerClose
+	writerFree         writerFree
+
+	operatorPresignRead         operatorPresign
+	operatorPresignWrite        operatorPresign
+	operatorPresignStat         operatorPresign
+	presignedRequestMethod      presignedRequestString
+	presignedRequestURI         presignedRequestString
+	presignedRequestHeadersLen  presignedRequestHeadersLen
+	presignedRequestHeaderKey   presignedRequestHeaderAt
+	presignedRequestHeaderValue presignedRequestHeaderAt
+	presignedRequestFree        presignedRequestFree
+}
+
+func resolveSymbols(libopendal uintptr) (*symbols, error) {
+	syms := &symbols{}
+	l := &symbolLoader{syms: syms, libopendal: libopendal}
+
+	resolve(l, &syms.bytesFree, withBytesFree)
+	resolve(l, &syms.errorFree, withErrorFree)
+
+	resolve(l, &syms.operatorOptionsNew, withOperatorOptionsNew)
+	resolve(l, &syms.operatorOptionSet, withOperatorOptionsSet)
+	resolve(l, &syms.operatorOptionsFree, withOperatorOptionsFree)
+
+	resolve(l, &syms.operatorNew, withOperatorNew)
+	resolve(l, &syms.operatorFree, withOperatorFree)
+
+	resolve(l, &syms.operatorInfoNew, withOperatorInfoNew)
+	resolve(l, &syms.operatorInfoGetFullCapability, withOperatorInfoGetFullCapability)
+	resolve(l, &syms.operatorInfoGetNativeCapability, withOperatorInfoGetNativeCapability)
+	resolve(l, &syms.operatorInfoProbeCapability, withOperatorInfoProbeCapability)
+	resolve(l, &syms.operatorInfoGetScheme, withOperatorInfoGetScheme)
+	resolve(l, &syms.operatorInfoGetRoot, withOperatorInfoGetRoot)
+	resolve(l, &syms.operatorInfoGetName, withOperatorInfoGetName)
+	resolve(l, &syms.operatorInfoFree, withOperatorInfoFree)
+
+	resolve(l, &syms.operatorCreateDir, withOperatorCreateDir)
+	resolve(l, &syms.operatorRead, withOperatorRead)
+	resolve(l, &syms.operatorWrite, withOperatorWrite)
+	resolve(l, &syms.operatorWriteWith, withOperatorWriteWith)
+	resolve(l, &syms.operatorDelete, withOperatorDelete)
+	resolve(l, &syms.operatorDeleteMany, withOperatorDeleteMany)
+	resolve(l, &syms.operatorStat, withOperatorStat)
+	resolve(l, &syms.operatorStatWith, withOperatorStatWith)
+	resolve(l, &syms.operatorIsExist, withOperatorIsExists)
+	resolve(l, &syms.operatorCopy, withOperatorCopy)
+	resolve(l, &syms.operatorRename, withOperatorRename)
+
+	resolve(l, &syms.metadataContentLength, withMetaContentLength)
+	resolve(l, &syms.metadataIsFile, withMetaIsFile)
+	resolve(l, &syms.metadataIsDir, withMetaIsDir)
+	resolve(l, &syms.metadataLastModified, withMetaLastModified)
+	resolve(l, &syms.metadataETag, withMetaETag)
+	resolve(l, &syms.metadataContentType, withMetaContentType)
+	resolve(l, &syms.metadataContentMD5, withMetaContentMD5)
+	resolve(l, &syms.metadataContentDisposition, withMetaContentDisposition)
+	resolve(l, &syms.metadataCacheControl, withMetaCacheControl)
+	resolve(l, &syms.metadataVersion, withMetaVersion)
+	resolve(l, &syms.metadataUserMetadataLen, withMetaUserMetadataLen)
+	resolve(l, &syms.metadataUserMetadataKey, withMetaUserMetadataKey)
+	resolve(l, &syms.metadataUserMetadataValue, withMetaUserMetadataValue)
+	resolve(l, &syms.metadataFree, withMetaFree)
+
+	resolve(l, &syms.operatorList, withOperatorList)
+	resolve(l, &syms.listerNext, withListerNext)
+	resolve(l, &syms.listerFree, withListerFree)
+	resolve(l, &syms.entryName, withEntryName)
+	resolve(l, &syms.entryPath, withEntryPath)
+	resolve(l, &syms.entryMetadata, withEntryMetadata)
+	resolve(l, &syms.entryFree, withEntryFree)
+
+	resolve(l, &syms.operatorReader, withOperatorReader)
+	resolve(l, &syms.readerRead, withReaderRead)
+	resolve(l, &syms.readerFree, withReaderFree)
+	resolve(l, &syms.readerSeek, withReaderSeek)
+
+	resolve(l, &syms.operatorWriter, withOperatorWriter)
+	resolve(l, &syms.operatorWriterWith, withOperatorWriterWith)
+	resolve(l, &syms.writerWrite, withWriterWrite)
+	resolve(l, &syms.writerClose, withWriterClose)
+	resolve(l, &syms.writerFree, withWriterFree)
+
+	resolve(l, &syms.operatorPresignRead, withOperatorPresignRead)
+	resolve(l, &syms.operatorPresignWrite, withOperatorPresignWrite)
+	resolve(l, &syms.operatorPresignStat, withOperatorPresignStat)
+	resolve(l, &syms.presignedRequestMethod, withPresignedRequestMethod)
+	resolve(l, &syms.presignedRequestURI, withPresignedRequestURI)
+	resolve(l, &syms.presignedRequestHeadersLen, withPresignedRequestHeadersLen)
+	resolve(l, &syms.presignedRequestHeaderKey, withPresignedRequestHeaderKey)
+	resolve(l, &syms.presignedRequestHeaderValue, withPresignedRequestHeaderValue)
+	resolve(l, &syms.presignedRequestFree, withPresignedRequestFree)
+
+	return syms, l.err
 }
 
-type contextWithFFI func(ctx context.Context, libopendal uintptr) (context.Context, error)
+// symbolLoader resolves the fields of syms one after another, and stops at the
+// first error.
+type symbolLoader struct {
+	syms       *symbols
+	libopendal uintptr
+	err        error
+}
 
-func getFFI[T any](ctx context.Context, key string) T {
-	return ctx.Value(key).(T)
+func resolve[T any](l *symbolLoader, fn *T, load ffiLoader[T]) {
+	if l.err != nil {
+		return
+	}
+	*fn, l.err = load(l.syms, l.libopendal)
 }
 
+// ffiLoader prepares a symbol of libopendal. The function it returns may use
+// the other fields of syms, which are all resolved before it is called.
+type ffiLoader[T any] func(syms *symbols, libopendal uintptr) (T, error)
+
 type ffiOpts struct {
 	sym    string
 	rType  *ffi.Type
 	aTypes []*ffi.Type
+	// optional symbols may be missing from older builds of the C binding.
+	// They are left nil instead of failing NewOperator.
+	optional bool
 }
 
 func withFFI[T any](
 	opts ffiOpts,
 	withFunc func(
-		ctx context.Context,
+		syms *symbols,
 		ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer),
 	) T,
-) func(ctx context.Context, libopendal uintptr) (context.Context, error) {
-	return func(ctx context.Context, libopendal uintptr) (context.Context, error) {
+) ffiLoader[T] {
+	return func(syms *symbols, libopendal uintptr) (fn T, err error) {
 		var cif ffi.Cif
 		if status := ffi.PrepCif(
 			&cif,
@@ -55,63 +279,19 @@ func withFFI[T any](
 			opts.rType,
 			opts.aTypes...,
 		); status != ffi.OK {
-			return nil, errors.New(status.String())
+			err = errors.New(status.String())
+			return
 		}
-		fn, err := purego.Dlsym(libopendal, opts.sym)
+		sym, err := purego.Dlsym(libopendal, opts.sym)
 		if err != nil {
-			return nil, err
+			if opts.optional {
+				err = nil
+			}
+			return
 		}
-		return context.WithValue(ctx, opts.sym,
-			withFunc(ctx, func(rValue unsafe.Pointer, aValues ...unsafe.Pointer) {
-				ffi.Call(&cif, fn, rValue, aValues...)
-			}),
-		), nil
+		fn = withFunc(syms, func(rValue unsafe.Pointer, aValues ...unsafe.Pointer) {
+			ffi.Call(&cif, sym, rValue, aValues...)
+		})
+		return
 	}
 }
-
-var withFFIs = []contextWithFFI{
-	// free must be on top
-	withBytesFree,
-	withErrorFree,
-
-	withOperatorOptionsNew,
-	withOperatorOptionsSet,
-	withOperatorOptionsFree,
-
-	withOperatorNew,
-	withOperatorFree,
-
-	withOperatorInfoNew,
-	withOperatorInfoGetFullCapability,
-	withOperatorInfoGetNativeCapability,
-	withOperatorInfoGetScheme,
-	withOperatorInfoGetRoot,
-	withOperatorInfoGetName,
-	withOperatorInfoFree,
-
-	withOperatorCreateDir,
-	withOperatorRead,
-	withOperatorWrite,
-	withOperatorDelete,
-	withOperatorStat,
-	withOperatorIsExists,
-	withOperatorCopy,
-	withOperatorRename,
-
-	withMetaContentLength,
-	withMetaIsFile,
-	withMetaIsDir,
-	withMetaLastModified,
-	withMetaFree,
-
-	withOperatorList,
-	withListerNext,
-	withListerFree,
-	withEntryName,
-	withEntryPath,
-	withEntryFree,
-
-	withOperatorReader,
-	withReaderRead,
-	withReaderFree,
-}
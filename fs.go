@@ -0,0 +1,364 @@
+package opendal
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// FS returns a read-only view of the Operator as an fs.FS.
+//
+// The returned file system also implements fs.ReadDirFS, fs.StatFS, fs.ReadFileFS
+// and fs.SubFS, so it can be used with the standard library, for example with
+// http.FS or template.ParseFS.
+//
+// # Notes
+//
+//   - Names follow the rules of fs.ValidPath: they are relative to the root of the
+//     Operator, separated by slashes, and "." is the root itself.
+//   - Directories are looked up with Stat on their path with a trailing slash, and
+//     listed with List.
+//   - Files opened from the file system are backed by an OperatorReader, and also
+//     implement io.Seeker, io.ReaderAt and io.WriterTo.
+//   - The FileInfo of a file returns its *Metadata from Sys.
+//
+// # Example
+//
+//	func exampleFS(op *opendal.Operator) {
+//		tmpl, err := template.ParseFS(op.FS(), "templates/*.html")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		http.Handle("/static/", http.FileServer(http.FS(op.FS())))
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) FS() fs.FS {
+	return &ioFS{op: op}
+}
+
+// ioFS is the fs.FS returned by Operator.FS.
+type ioFS struct {
+	op *Operator
+	// root is the directory of the file system, either empty or with a trailing slash.
+	root string
+}
+
+var (
+	_ fs.ReadDirFS  = (*ioFS)(nil)
+	_ fs.StatFS     = (*ioFS)(nil)
+	_ fs.ReadFileFS = (*ioFS)(nil)
+	_ fs.SubFS      = (*ioFS)(nil)
+)
+
+// path returns the path of name in the Operator.
+func (f *ioFS) path(name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", fs.ErrInvalid
+	}
+	if name == "." {
+		return f.root, nil
+	}
+	return f.root + name, nil
+}
+
+// stat returns the path and metadata of name, which is looked up as a file first
+// and as a directory otherwise.
+func (f *ioFS) stat(name string) (string, *Metadata, error) {
+	p, err := f.path(name)
+	if err != nil {
+		return "", nil, err
+	}
+	if name != "." {
+		meta, err := f.op.Stat(p)
+		if err == nil {
+			return p, meta, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return "", nil, err
+		}
+		p += "/"
+	}
+	meta, err := f.op.Stat(p)
+	if err != nil {
+		return "", nil, err
+	}
+	return p, meta, nil
+}
+
+func (f *ioFS) Open(name string) (fs.File, error) {
+	p, meta, err := f.stat(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	info := &fileInfo{name: path.Base(name), meta: meta}
+	if meta.IsDir() {
+		return &ioDir{fs: f, path: p, info: info}, nil
+	}
+	r, err := f.op.Reader(p)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	return &ioFile{r: r, info: info}, nil
+}
+
+func (f *ioFS) Stat(name string) (fs.FileInfo, error) {
+	_, meta, err := f.stat(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+	return &fileInfo{name: path.Base(name), meta: meta}, nil
+}
+
+func (f *ioFS) ReadFile(name string) ([]byte, error) {
+	p, err := f.path(name)
+	if err == nil {
+		var data []byte
+		data, err = f.op.Read(p)
+		if err == nil {
+			return data, nil
+		}
+	}
+	return nil, &fs.PathError{Op: "read", Path: name, Err: err}
+}
+
+func (f *ioFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	p, meta, err := f.stat(name)
+	if err == nil && !meta.IsDir() {
+		err = errNotDir
+	}
+	var entries []fs.DirEntry
+	if err == nil {
+		entries, err = f.readDir(p)
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	return entries, nil
+}
+
+func (f *ioFS) Sub(dir string) (fs.FS, error) {
+	if dir == "." {
+		return f, nil
+	}
+	p, meta, err := f.stat(dir)
+	if err == nil && !meta.IsDir() {
+		err = errNotDir
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: "sub", Path: dir, Err: err}
+	}
+	return &ioFS{op: f.op, root: p}, nil
+}
+
+var errNotDir = errors.New("not a directory")
+
+// readDir lists the directory at p, sorted by name.
+func (f *ioFS) readDir(p string) ([]fs.DirEntry, error) {
+	lister, err := f.op.List(p)
+	if err != nil {
+		return nil, err
+	}
+	defer lister.Close()
+
+	var entries []fs.DirEntry
+	for lister.Next() {
+		entry := lister.Entry()
+		if entry.Path() == p {
+			continue
+		}
+		entries = append(entries, &dirEntry{
+			fs:   f,
+			path: entry.Path(),
+			name: strings.TrimSuffix(entry.Name(), "/"),
+		})
+	}
+	if err := lister.Error(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return entries, nil
+}
+
+// ioFile is a file opened from the fs.FS returned by Operator.FS.
+//
+// The reader is not embedded, so that every method checks whether the file is
+// closed before using it.
+type ioFile struct {
+	r      *OperatorReader
+	info   *fileInfo
+	closed bool
+}
+
+var (
+	_ io.Seeker   = (*ioFile)(nil)
+	_ io.ReaderAt = (*ioFile)(nil)
+	_ io.WriterTo = (*ioFile)(nil)
+)
+
+func (f *ioFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *ioFile) Read(p []byte) (int, error) {
+	if f.closed {
+		return 0, fs.ErrClosed
+	}
+	return f.r.Read(p)
+}
+
+func (f *ioFile) Seek(offset int64, whence int) (int64, error) {
+	if f.closed {
+		return 0, fs.ErrClosed
+	}
+	return f.r.Seek(offset, whence)
+}
+
+func (f *ioFile) ReadAt(p []byte, off int64) (int, error) {
+	if f.closed {
+		return 0, fs.ErrClosed
+	}
+	return f.r.ReadAt(p, off)
+}
+
+func (f *ioFile) WriteTo(w io.Writer) (int64, error) {
+	if f.closed {
+		return 0, fs.ErrClosed
+	}
+	return f.r.WriteTo(w)
+}
+
+func (f *ioFile) Close() error {
+	if f.closed {
+		return fs.ErrClosed
+	}
+	f.closed = true
+	return f.r.Close()
+}
+
+// ioDir is a directory opened from the fs.FS returned by Operator.FS.
+type ioDir struct {
+	fs   *ioFS
+	path string
+	info *fileInfo
+
+	// entries are listed by the first call to ReadDir, offset is the number
+	// of entries returned so far.
+	entries []fs.DirEntry
+	listed  bool
+	offset  int
+	closed  bool
+}
+
+var _ fs.ReadDirFile = (*ioDir)(nil)
+
+func (d *ioDir) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *ioDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: errors.New("is a directory")}
+}
+
+func (d *ioDir) Close() error {
+	if d.closed {
+		return fs.ErrClosed
+	}
+	d.closed = true
+	return nil
+}
+
+func (d *ioDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if d.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: d.info.name, Err: fs.ErrClosed}
+	}
+	if !d.listed {
+		entries, err := d.fs.readDir(d.path)
+		if err != nil {
+			return nil, &fs.PathError{Op: "readdir", Path: d.info.name, Err: err}
+		}
+		d.entries, d.listed = entries, true
+	}
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return remaining, nil
+	}
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(remaining))
+	d.offset += n
+	return remaining[:n], nil
+}
+
+// dirEntry is an entry of a directory listed from the fs.FS returned by Operator.FS.
+type dirEntry struct {
+	fs   *ioFS
+	path string
+	name string
+}
+
+func (e *dirEntry) Name() string {
+	return e.name
+}
+
+func (e *dirEntry) IsDir() bool {
+	return strings.HasSuffix(e.path, "/")
+}
+
+func (e *dirEntry) Type() fs.FileMode {
+	if e.IsDir() {
+		return fs.ModeDir
+	}
+	return 0
+}
+
+// Info fetches the metadata of the entry with Stat.
+func (e *dirEntry) Info() (fs.FileInfo, error) {
+	meta, err := e.fs.op.Stat(e.path)
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: e.name, Err: err}
+	}
+	return &fileInfo{name: e.name, meta: meta}, nil
+}
+
+// fileInfo maps Metadata to fs.FileInfo.
+type fileInfo struct {
+	name string
+	meta *Metadata
+}
+
+func (i *fileInfo) Name() string {
+	return i.name
+}
+
+func (i *fileInfo) Size() int64 {
+	return int64(i.meta.ContentLength())
+}
+
+func (i *fileInfo) Mode() fs.FileMode {
+	if i.meta.IsDir() {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+func (i *fileInfo) ModTime() time.Time {
+	return i.meta.LastModified()
+}
+
+func (i *fileInfo) IsDir() bool {
+	return i.meta.IsDir()
+}
+
+// Sys returns the *Metadata of the file.
+func (i *fileInfo) Sys() any {
+	return i.meta
+}
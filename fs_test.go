@@ -0,0 +1,105 @@
+package opendal_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsFS(cap *opendal.Capability) []behaviorTest {
+	if !cap.List() || !cap.Stat() || !cap.Read() || !cap.Write() {
+		return nil
+	}
+	return []behaviorTest{
+		testFS,
+		testFSReadFile,
+		testFSNotExist,
+		testFSClosed,
+	}
+}
+
+func testFS(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+	names := []string{"a", "b/c", "b/d/e"}
+	for _, name := range names {
+		path, content, _ := fixture.NewFileWithRange(parent+name, 1, 256)
+		assert.Nil(op.Write(path, content), "write must succeed")
+	}
+
+	fsys, err := fs.Sub(op.FS(), strings.TrimSuffix(parent, "/"))
+	assert.Nil(err)
+	assert.Nil(fstest.TestFS(fsys, names...))
+
+	entries, err := fs.ReadDir(fsys, "b")
+	assert.Nil(err)
+	assert.Len(entries, 2)
+	assert.Equal("c", entries[0].Name())
+	assert.False(entries[0].IsDir())
+	assert.Equal("d", entries[1].Name())
+	assert.True(entries[1].IsDir())
+}
+
+func testFSReadFile(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	data, err := fs.ReadFile(op.FS(), path)
+	assert.Nil(err)
+	assert.Equal(content, data)
+
+	info, err := fs.Stat(op.FS(), path)
+	assert.Nil(err)
+	assert.Equal(path, info.Name())
+	assert.Equal(int64(size), info.Size())
+	assert.False(info.IsDir())
+	meta, ok := info.Sys().(*opendal.Metadata)
+	assert.True(ok)
+	assert.Equal(uint64(size), meta.ContentLength())
+}
+
+func testFSNotExist(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+
+	_, err := op.FS().Open(path)
+	assert.True(errors.Is(err, fs.ErrNotExist), "open must fail with fs.ErrNotExist: %v", err)
+
+	_, err = fs.Stat(op.FS(), path)
+	assert.True(errors.Is(err, fs.ErrNotExist), "stat must fail with fs.ErrNotExist: %v", err)
+
+	_, err = op.FS().Open(fmt.Sprintf("/%s", path))
+	assert.True(errors.Is(err, fs.ErrInvalid), "open must fail with fs.ErrInvalid: %v", err)
+}
+
+func testFSClosed(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	f, err := op.FS().Open(path)
+	assert.Nil(err)
+	assert.Nil(f.Close())
+	assert.ErrorIs(f.Close(), fs.ErrClosed)
+
+	_, err = f.Read(make([]byte, 1))
+	assert.ErrorIs(err, fs.ErrClosed)
+	_, err = f.(io.Seeker).Seek(0, io.SeekStart)
+	assert.ErrorIs(err, fs.ErrClosed)
+	_, err = f.(io.ReaderAt).ReadAt(make([]byte, 1), 0)
+	assert.ErrorIs(err, fs.ErrClosed)
+	_, err = f.(io.WriterTo).WriteTo(io.Discard)
+	assert.ErrorIs(err, fs.ErrClosed)
+
+	dirPath := fixture.NewDirPath()
+	assert.Nil(op.CreateDir(dirPath), "create dir must succeed")
+	dir, err := op.FS().Open(strings.TrimSuffix(dirPath, "/"))
+	assert.Nil(err)
+	assert.Nil(dir.Close())
+	assert.ErrorIs(dir.Close(), fs.ErrClosed)
+	_, err = dir.(fs.ReadDirFile).ReadDir(-1)
+	assert.ErrorIs(err, fs.ErrClosed)
+}
@@ -0,0 +1,207 @@
+package opendal
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Handler returns an http.Handler that serves the files of the Operator.
+//
+// The path of each request is cleaned and mapped to the Operator path with the same
+// name, without the leading slash. Use http.StripPrefix to serve the Operator under a
+// prefix.
+//
+// Files are served with http.ServeContent and streamed through an OperatorReader, so
+// they are never buffered in memory. This supports Range requests as well as the
+// If-Match, If-None-Match, If-Modified-Since, If-Unmodified-Since and If-Range
+// conditions, based on the ETag and last modified time of the metadata.
+//
+// Each request makes a single Stat, whose content length is also the size of the
+// reader. A Range request moves the reader to the start of the range with Seek, so
+// its cost depends on the C binding: with `opendal_reader_seek` the reader jumps to
+// the range, and without it the bytes before the range are read and discarded, as
+// the C binding has no other way to read from an offset.
+//
+// # Parameters
+//
+//   - opts: Options such as HandlerListDirectories.
+//
+// # Notes
+//
+//   - Only GET and HEAD requests are served; other methods get 405 Method Not Allowed.
+//   - The Content-Type is taken from the metadata when the backend supplies it, and
+//     otherwise detected from the file extension or contents.
+//   - Errors are reported as 404 Not Found, 403 Forbidden or 500 Internal Server Error,
+//     without the error message.
+//   - Requests for directories get 404 Not Found unless HandlerListDirectories is set.
+//
+// # Example
+//
+//	func exampleHandler(op *opendal.Operator) {
+//		http.Handle("/files/", http.StripPrefix("/files", op.Handler(
+//			opendal.HandlerListDirectories(),
+//		)))
+//		log.Fatal(http.ListenAndServe(":8080", nil))
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) Handler(opts ...HandlerOption) http.Handler {
+	h := &handler{op: op}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// HandlerOption configures Handler.
+type HandlerOption func(h *handler)
+
+// HandlerListDirectories serves requests for directories with an HTML listing of
+// their entries, rendered from a Lister in the order it returns them.
+//
+// Requests for a directory without a trailing slash are redirected to the path
+// with a trailing slash, like http.FileServer does.
+func HandlerListDirectories() HandlerOption {
+	return func(h *handler) {
+		h.listDirectories = true
+	}
+}
+
+type handler struct {
+	op              *Operator
+	listDirectories bool
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	name := path.Clean("/" + r.URL.Path)
+	p := strings.TrimPrefix(name, "/")
+	if p == "" || strings.HasSuffix(r.URL.Path, "/") {
+		if p != "" {
+			p += "/"
+		}
+		h.serveDir(w, r, p)
+		return
+	}
+	h.serveFile(w, r, name, p)
+}
+
+func (h *handler) serveFile(w http.ResponseWriter, r *http.Request, name, p string) {
+	ctx := r.Context()
+	meta, err := h.op.StatContext(ctx, p)
+	if err != nil {
+		if h.listDirectories && errors.Is(err, ErrNotFound) {
+			if meta, err := h.op.StatContext(ctx, p+"/"); err == nil && meta.IsDir() {
+				redirectDir(w, r, path.Base(name))
+				return
+			}
+		}
+		httpError(w, err)
+		return
+	}
+	if meta.IsDir() {
+		httpError(w, ErrNotFound)
+		return
+	}
+
+	reader, err := h.op.ReaderContext(ctx, p)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	defer reader.Close()
+	// http.ServeContent seeks to the end to find the size, which is already known.
+	reader.size = int64(meta.ContentLength())
+
+	if etag, ok := meta.ETag(); ok && etag != "" {
+		w.Header().Set("Etag", quoteETag(etag))
+	}
+	if contentType, ok := meta.ContentType(); ok && contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	http.ServeContent(w, r, name, meta.LastModified(), reader)
+}
+
+func (h *handler) serveDir(w http.ResponseWriter, r *http.Request, p string) {
+	if !h.listDirectories {
+		httpError(w, ErrNotFound)
+		return
+	}
+	ctx := r.Context()
+	meta, err := h.op.StatContext(ctx, p)
+	if err == nil && !meta.IsDir() {
+		err = ErrNotFound
+	}
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	lister, err := h.op.ListContext(ctx, p)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	defer lister.Close()
+
+	var names []string
+	for lister.Next() {
+		entry := lister.Entry()
+		if entry.Path() == p {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	if err := lister.Error(); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintf(w, "<!doctype html>\n<meta name=\"viewport\" content=\"width=device-width\">\n<pre>\n")
+	for _, name := range names {
+		link := url.URL{Path: name}
+		fmt.Fprintf(w, "<a href=\"%s\">%s</a>\n", link.String(), html.EscapeString(name))
+	}
+	fmt.Fprintf(w, "</pre>\n")
+}
+
+// redirectDir redirects the request to the directory named dir, relative to the
+// requested path.
+func redirectDir(w http.ResponseWriter, r *http.Request, dir string) {
+	target := dir + "/"
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+	w.Header().Set("Location", target)
+	w.WriteHeader(http.StatusMovedPermanently)
+}
+
+// quoteETag returns etag as a quoted HTTP entity tag, as some backends return
+// entity tags without quotes.
+func quoteETag(etag string) string {
+	if strings.HasPrefix(etag, `"`) || strings.HasPrefix(etag, `W/"`) {
+		return etag
+	}
+	return `"` + etag + `"`
+}
+
+// httpError replies to the request with the HTTP status matching err.
+func httpError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, ErrNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, ErrPermissionDenied):
+		code = http.StatusForbidden
+	}
+	http.Error(w, http.StatusText(code), code)
+}
@@ -0,0 +1,65 @@
+package opendal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerRange(t *testing.T) {
+	for _, seekable := range []bool{true, false} {
+		assert := require.New(t)
+
+		f := &fakeFile{content: make([]byte, 4096), seekable: seekable}
+		for i := range f.content {
+			f.content[i] = byte(i)
+		}
+		op := f.operator()
+
+		req := httptest.NewRequest(http.MethodGet, "/file.txt", nil)
+		req.Header.Set("Range", "bytes=1000-1099")
+		rec := httptest.NewRecorder()
+		op.Handler().ServeHTTP(rec, req)
+
+		assert.Equal(http.StatusPartialContent, rec.Code)
+		assert.Equal(f.content[1000:1100], rec.Body.Bytes())
+		assert.Equal(1, f.stats, "the size of the reader must be taken from the metadata")
+		if seekable {
+			assert.Equal(100, f.bytesRead, "the reader must seek to the range")
+		} else {
+			assert.Equal(1100, f.bytesRead, "the prefix is discarded without opendal_reader_seek")
+		}
+	}
+}
+
+func TestHandlerListError(t *testing.T) {
+	assert := require.New(t)
+
+	op := (&fakeFile{}).operator()
+	op.syms.metadataIsFile = func(*opendalMetadata) bool { return false }
+	op.syms.metadataIsDir = func(*opendalMetadata) bool { return true }
+	op.syms.operatorList = func(*opendalOperator, string) (*opendalLister, error) {
+		return &opendalLister{}, nil
+	}
+	var listed int
+	op.syms.listerNext = func(*opendalLister) (*opendalEntry, error) {
+		listed++
+		if listed > 1 {
+			return nil, &Error{code: CodePermissioDenied, message: "denied"}
+		}
+		return &opendalEntry{}, nil
+	}
+	op.syms.listerFree = func(*opendalLister) {}
+	op.syms.entryName = func(*opendalEntry) string { return "file.txt" }
+	op.syms.entryPath = func(*opendalEntry) string { return "dir/file.txt" }
+	op.syms.entryFree = func(*opendalEntry) {}
+
+	req := httptest.NewRequest(http.MethodGet, "/dir/", nil)
+	rec := httptest.NewRecorder()
+	op.Handler(HandlerListDirectories()).ServeHTTP(rec, req)
+
+	assert.Equal(http.StatusForbidden, rec.Code, "a failed listing must not be served as a partial page")
+	assert.NotContains(rec.Body.String(), "file.txt")
+}
@@ -0,0 +1,168 @@
+package opendal_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsHandler(cap *opendal.Capability) []behaviorTest {
+	if !cap.Stat() || !cap.Read() || !cap.Write() {
+		return nil
+	}
+	tests := []behaviorTest{
+		testHandler,
+		testHandlerRange,
+		testHandlerConditional,
+		testHandlerNotFound,
+		testHandlerMethodNotAllowed,
+	}
+	if cap.List() {
+		tests = append(tests, testHandlerListDirectories, testHandlerDirectoryDisabled)
+	}
+	return tests
+}
+
+func serveHandler(h http.Handler, method, target string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func testHandler(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	rec := serveHandler(op.Handler(), http.MethodGet, "/"+path, nil)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal(fmt.Sprint(size), rec.Header().Get("Content-Length"))
+	assert.Equal("bytes", rec.Header().Get("Accept-Ranges"))
+	assert.NotEmpty(rec.Header().Get("Content-Type"))
+	assert.Equal(content, rec.Body.Bytes())
+
+	rec = serveHandler(op.Handler(), http.MethodHead, "/"+path, nil)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal(fmt.Sprint(size), rec.Header().Get("Content-Length"))
+	assert.Empty(rec.Body.Bytes())
+}
+
+func testHandlerRange(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(fixture.NewFilePath(), 100, 4096)
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	rec := serveHandler(op.Handler(), http.MethodGet, "/"+path, http.Header{
+		"Range": {"bytes=10-49"},
+	})
+	assert.Equal(http.StatusPartialContent, rec.Code)
+	assert.Equal(fmt.Sprintf("bytes 10-49/%d", size), rec.Header().Get("Content-Range"))
+	assert.Equal(content[10:50], rec.Body.Bytes())
+
+	rec = serveHandler(op.Handler(), http.MethodGet, "/"+path, http.Header{
+		"Range": {"bytes=-20"},
+	})
+	assert.Equal(http.StatusPartialContent, rec.Code)
+	assert.Equal(content[size-20:], rec.Body.Bytes())
+
+	rec = serveHandler(op.Handler(), http.MethodGet, "/"+path, http.Header{
+		"Range": {fmt.Sprintf("bytes=%d-", size)},
+	})
+	assert.Equal(http.StatusRequestedRangeNotSatisfiable, rec.Code)
+}
+
+func testHandlerConditional(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+
+	if etag, ok := meta.ETag(); ok && etag != "" {
+		rec := serveHandler(op.Handler(), http.MethodGet, "/"+path, nil)
+		etag = rec.Header().Get("Etag")
+		assert.NotEmpty(etag)
+
+		rec = serveHandler(op.Handler(), http.MethodGet, "/"+path, http.Header{
+			"If-None-Match": {etag},
+		})
+		assert.Equal(http.StatusNotModified, rec.Code)
+		assert.Empty(rec.Body.Bytes())
+
+		rec = serveHandler(op.Handler(), http.MethodGet, "/"+path, http.Header{
+			"If-None-Match": {`"not-the-etag"`},
+		})
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Equal(content, rec.Body.Bytes())
+	}
+
+	if modified := meta.LastModified(); !modified.IsZero() {
+		rec := serveHandler(op.Handler(), http.MethodGet, "/"+path, nil)
+		lastModified := rec.Header().Get("Last-Modified")
+		assert.Equal(modified.UTC().Format(http.TimeFormat), lastModified)
+
+		rec = serveHandler(op.Handler(), http.MethodGet, "/"+path, http.Header{
+			"If-Modified-Since": {lastModified},
+		})
+		assert.Equal(http.StatusNotModified, rec.Code)
+	}
+}
+
+func testHandlerNotFound(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+
+	rec := serveHandler(op.Handler(), http.MethodGet, "/"+path, nil)
+	assert.Equal(http.StatusNotFound, rec.Code)
+}
+
+func testHandlerMethodNotAllowed(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	rec := serveHandler(op.Handler(), http.MethodPut, "/"+path, nil)
+	assert.Equal(http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal("GET, HEAD", rec.Header().Get("Allow"))
+}
+
+func testHandlerListDirectories(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+	for _, name := range []string{"a.txt", "b/c.txt"} {
+		path, content, _ := fixture.NewFileWithRange(parent+name, 1, 256)
+		assert.Nil(op.Write(path, content), "write must succeed")
+	}
+	h := op.Handler(opendal.HandlerListDirectories())
+
+	rec := serveHandler(h, http.MethodGet, "/"+parent, nil)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	assert.Contains(body, `<a href="a.txt">a.txt</a>`)
+	assert.Contains(body, `<a href="b/">b/</a>`)
+	assert.NotContains(body, "c.txt")
+
+	dir := strings.TrimSuffix(parent, "/")
+	rec = serveHandler(h, http.MethodGet, "/"+dir+"/b?x=1", nil)
+	assert.Equal(http.StatusMovedPermanently, rec.Code)
+	assert.Equal("b/?x=1", rec.Header().Get("Location"))
+
+	rec = serveHandler(h, http.MethodGet, "/"+dir+"/missing/", nil)
+	assert.Equal(http.StatusNotFound, rec.Code)
+}
+
+func testHandlerDirectoryDisabled(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+	path, content, _ := fixture.NewFileWithRange(parent+"a.txt", 1, 256)
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	rec := serveHandler(op.Handler(), http.MethodGet, "/"+parent, nil)
+	assert.Equal(http.StatusNotFound, rec.Code)
+
+	rec = serveHandler(op.Handler(), http.MethodGet, "/"+strings.TrimSuffix(parent, "/"), nil)
+	assert.Equal(http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,195 @@
+package opendal
+
+import (
+	"context"
+	"time"
+)
+
+// Storage is the method set of Operator.
+//
+// Code that only needs to access data can depend on Storage instead of *Operator,
+// which allows wrapping or replacing the operator, for example with a fake in tests.
+type Storage interface {
+	Close()
+
+	Info() *OperatorInfo
+	Check() error
+
+	Read(path string) ([]byte, error)
+	ReadContext(ctx context.Context, path string) ([]byte, error)
+	ReadWith(path string, opts ...ReadOption) ([]byte, error)
+	ReadWithContext(ctx context.Context, path string, opts ...ReadOption) ([]byte, error)
+	Reader(path string) (*OperatorReader, error)
+	ReaderContext(ctx context.Context, path string) (*OperatorReader, error)
+	ReaderWith(path string, opts ...ReadOption) (*OperatorReader, error)
+	ReaderWithContext(ctx context.Context, path string, opts ...ReadOption) (*OperatorReader, error)
+	ReadInto(path string, buf []byte) (int, error)
+	ReadIntoContext(ctx context.Context, path string, buf []byte) (int, error)
+	ReadPooled(path string) (*PooledBuffer, error)
+	ReadPooledContext(ctx context.Context, path string) (*PooledBuffer, error)
+
+	Write(path string, data []byte) error
+	WriteContext(ctx context.Context, path string, data []byte) error
+	Append(path string, data []byte) error
+	AppendContext(ctx context.Context, path string, data []byte) error
+	Writer(path string) (*OperatorWriter, error)
+	WriterContext(ctx context.Context, path string) (*OperatorWriter, error)
+	CreateDir(path string) error
+	CreateDirContext(ctx context.Context, path string) error
+
+	Stat(path string) (*Metadata, error)
+	StatContext(ctx context.Context, path string) (*Metadata, error)
+	StatWith(path string, opts ...StatOption) (*Metadata, error)
+	StatWithContext(ctx context.Context, path string, opts ...StatOption) (*Metadata, error)
+	IsExist(path string) (bool, error)
+	IsExistContext(ctx context.Context, path string) (bool, error)
+
+	List(path string) (*Lister, error)
+	ListContext(ctx context.Context, path string) (*Lister, error)
+	ListWith(path string, opts ...ListOption) (*Lister, error)
+	ListWithContext(ctx context.Context, path string, opts ...ListOption) (*Lister, error)
+
+	Delete(path string) error
+	DeleteContext(ctx context.Context, path string) error
+	DeleteMany(paths []string) error
+	DeleteManyContext(ctx context.Context, paths []string) error
+	RemoveAll(path string) error
+	RemoveAllContext(ctx context.Context, path string) error
+
+	Copy(src, dest string) error
+	CopyContext(ctx context.Context, src, dest string) error
+	Rename(src, dest string) error
+	RenameContext(ctx context.Context, src, dest string) error
+
+	PresignRead(path string, expire time.Duration) (*PresignedRequest, error)
+	PresignReadContext(ctx context.Context, path string, expire time.Duration) (*PresignedRequest, error)
+	PresignWrite(path string, expire time.Duration) (*PresignedRequest, error)
+	PresignWriteContext(ctx context.Context, path string, expire time.Duration) (*PresignedRequest, error)
+	PresignStat(path string, expire time.Duration) (*PresignedRequest, error)
+	PresignStatContext(ctx context.Context, path string, expire time.Duration) (*PresignedRequest, error)
+}
+
+var _ Storage = (*Operator)(nil)
+
+// Call describes an operation passed to an Interceptor.
+type Call struct {
+	// Operation is the name of the operation, such as "read", "read_with" or "stat".
+	// Operations on readers, writers and listers are prefixed with their type,
+	// such as "reader.read", "writer.close" or "lister.next".
+	Operation string
+	// Path is the path the operation acts on. For DeleteMany, it is empty and the
+	// paths are in Args. For reader, writer and lister operations, it is the path
+	// they were created for.
+	Path string
+	// Args holds the remaining arguments of the method, in order, such as the data
+	// of "write", the destination of "copy" or the buffer of "reader.read".
+	Args []any
+}
+
+// Invoker performs the intercepted operation.
+type Invoker func(ctx context.Context) error
+
+// Interceptor wraps every operation of an Operator created by Layer.
+//
+// An interceptor must call invoke to perform the operation, and may inspect the call,
+// measure its duration, change the error it returns, call invoke several times to
+// retry it, or not call it at all to reject it.
+//
+// # Example
+//
+//	func logging(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+//		start := time.Now()
+//		err := invoke(ctx)
+//		log.Printf("%s %s took %s: %v", call.Operation, call.Path, time.Since(start), err)
+//		return err
+//	}
+type Interceptor func(ctx context.Context, call *Call, invoke Invoker) error
+
+// Layer returns an Operator that passes every operation through the given interceptors.
+//
+// # Parameters
+//
+//   - interceptors: The interceptors to apply. Interceptors added later wrap the earlier
+//     ones, so the last interceptor sees a call first, including those added by previous
+//     calls to Layer.
+//
+// # Returns
+//
+//   - *Operator: An operator sharing the underlying connection with op. Closing either
+//     one closes both, and op itself keeps working without the interceptors.
+//
+// # Notes
+//
+//   - Readers, writers and listers created by the returned operator pass their Read, ReadAt,
+//     Write, Close and Next calls through the interceptors as well.
+//   - An operation that is implemented with other operations, such as RemoveAll, is only
+//     seen once by the interceptors.
+//
+// # Example
+//
+//	func exampleLayer(op *opendal.Operator) {
+//		logged := op.Layer(func(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+//			start := time.Now()
+//			err := invoke(ctx)
+//			log.Printf("%s %s took %s: %v", call.Operation, call.Path, time.Since(start), err)
+//			return err
+//		})
+//		data, err := logged.Read("path/to/file")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("Read: %s\n", data)
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) Layer(interceptors ...Interceptor) *Operator {
+	layered := *op
+	layered.interceptors = append(append([]Interceptor(nil), op.interceptors...), interceptors...)
+	return &layered
+}
+
+// interceptedKey marks the context of an operation that is already being intercepted.
+type interceptedKey struct{}
+
+// intercepting reports whether an operation called with ctx is seen by the interceptors.
+func (op *Operator) intercepting(ctx context.Context) bool {
+	return len(op.interceptors) > 0 && ctx.Value(interceptedKey{}) == nil
+}
+
+// intercept runs invoke through the interceptors of op, unless the call is made
+// on behalf of another operation that is already being intercepted.
+func (op *Operator) intercept(ctx context.Context, call *Call, invoke Invoker) error {
+	if !op.intercepting(ctx) {
+		return annotate(invoke(ctx), call)
+	}
+	return op.invoke(ctx, call, invoke)
+}
+
+// invoke runs invoke through the interceptors of op unconditionally.
+func (op *Operator) invoke(ctx context.Context, call *Call, invoke Invoker) error {
+	next := func(ctx context.Context) error {
+		return annotate(invoke(context.WithValue(ctx, interceptedKey{}, struct{}{})), call)
+	}
+	for _, interceptor := range op.interceptors {
+		interceptor, inner := interceptor, next
+		next = func(ctx context.Context) error {
+			return interceptor(ctx, call, inner)
+		}
+	}
+	// Errors returned by the interceptors themselves are annotated as well.
+	return annotate(next(ctx), call)
+}
+
+// interceptValue is intercept for operations that return a value.
+func interceptValue[T any](op *Operator, ctx context.Context, call *Call, invoke func(ctx context.Context) (T, error)) (T, error) {
+	var value T
+	err := op.intercept(ctx, call, func(ctx context.Context) (err error) {
+		value, err = invoke(ctx)
+		return
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value, nil
+}
@@ -0,0 +1,129 @@
+package opendal_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsLayer(cap *opendal.Capability) []behaviorTest {
+	if !cap.Read() || !cap.Write() || !cap.Stat() || !cap.List() {
+		return nil
+	}
+	return []behaviorTest{
+		testLayerObservesCalls,
+		testLayerObservesReaderAndLister,
+		testLayerRejectsCall,
+		testLayerOrder,
+	}
+}
+
+type recordedCall struct {
+	operation string
+	path      string
+	err       error
+}
+
+func recordCalls(calls *[]recordedCall) opendal.Interceptor {
+	return func(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+		err := invoke(ctx)
+		*calls = append(*calls, recordedCall{operation: call.Operation, path: call.Path, err: err})
+		return err
+	}
+}
+
+func testLayerObservesCalls(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	var calls []recordedCall
+	layered := op.Layer(recordCalls(&calls))
+
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(layered.Write(path, content), "write must succeed")
+	_, err := layered.Stat(path)
+	assert.Nil(err)
+	_, err = layered.Stat(fixture.NewFilePath())
+	assert.NotNil(err)
+
+	assert.Equal([]recordedCall{
+		{operation: "write", path: path},
+		{operation: "stat", path: path},
+		{operation: "stat", path: calls[2].path, err: err},
+	}, calls)
+
+	// The underlying operator is not affected.
+	_, err = op.Stat(path)
+	assert.Nil(err)
+	assert.Len(calls, 3)
+}
+
+func testLayerObservesReaderAndLister(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	var calls []recordedCall
+	layered := op.Layer(recordCalls(&calls))
+
+	parent := fixture.NewDirPath()
+	path, content, _ := fixture.NewFileWithPath(parent + "file")
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	r, err := layered.Reader(path)
+	assert.Nil(err)
+	buf := make([]byte, len(content))
+	n, err := r.Read(buf)
+	assert.Nil(err)
+	assert.Equal(content, buf[:n])
+	assert.Nil(r.Close())
+
+	lister, err := layered.List(parent)
+	assert.Nil(err)
+	for lister.Next() {
+	}
+	assert.Nil(lister.Error())
+	assert.Nil(lister.Close())
+
+	operations := map[string]bool{}
+	for _, call := range calls {
+		operations[call.operation] = true
+	}
+	assert.True(operations["reader"])
+	assert.True(operations["reader.read"])
+	assert.True(operations["list"])
+	assert.True(operations["lister.next"])
+}
+
+func testLayerRejectsCall(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	if !op.Info().GetFullCapability().Delete() {
+		return
+	}
+	errReadOnly := errors.New("read only")
+	layered := op.Layer(func(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+		if call.Operation == "delete" {
+			return errReadOnly
+		}
+		return invoke(ctx)
+	})
+
+	path, content, _ := fixture.NewFile()
+	assert.Nil(layered.Write(path, content), "write must succeed")
+
+	assert.ErrorIs(layered.Delete(path), errReadOnly)
+	exist, err := op.IsExist(path)
+	assert.Nil(err)
+	assert.True(exist)
+}
+
+func testLayerOrder(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	var order []string
+	named := func(name string) opendal.Interceptor {
+		return func(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+			order = append(order, name)
+			return invoke(ctx)
+		}
+	}
+	layered := op.Layer(named("first")).Layer(named("second"), named("third"))
+
+	path, content, _ := fixture.NewFile()
+	assert.Nil(layered.Write(path, content), "write must succeed")
+
+	assert.Equal([]string{"third", "second", "first"}, order)
+}
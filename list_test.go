@@ -2,6 +2,7 @@ package opendal_test
 
 import (
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 
@@ -24,6 +25,12 @@ func testsList(cap *opendal.Capability) []behaviorTest {
 		testListSubDir,
 		testListNestedDir,
 		testListDirWithFilePath,
+		testListWithRecursive,
+		testListWithStartAfter,
+		testListWithRecursiveStartAfter,
+		testListWithMetakeyMode,
+		testListWithMetakeyContentLength,
+		testListClosed,
 	}
 }
 
@@ -233,3 +240,142 @@ func testListDirWithFilePath(assert *require.Assertions, op *opendal.Operator, f
 	}
 	assert.Nil(obs.Error())
 }
+
+func testListWithRecursive(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+	dir := fixture.PushPath(fmt.Sprintf("%s%s/", parent, uuid.NewString()))
+	nested := fixture.PushPath(fmt.Sprintf("%s%s/", dir, uuid.NewString()))
+
+	expected := []string{dir, nested}
+	for _, prefix := range []string{parent, dir, nested} {
+		path, content, _ := fixture.NewFileWithRange(fmt.Sprintf("%s%s", prefix, uuid.NewString()), 1, 16)
+		assert.Nil(op.Write(path, content), "write must succeed")
+		expected = append(expected, path)
+	}
+
+	obs, err := op.ListWith(parent, opendal.ListRecursive())
+	assert.Nil(err)
+	defer obs.Close()
+	var actual []string
+	for obs.Next() {
+		actual = append(actual, obs.Entry().Path())
+	}
+	assert.Nil(obs.Error())
+
+	// Entries come out in lexicographical order, directories before their contents.
+	slices.Sort(expected)
+
+	assert.Equal(expected, actual)
+}
+
+func testListWithStartAfter(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+
+	var paths []string
+	for i := range 10 {
+		path, content, _ := fixture.NewFileWithRange(fmt.Sprintf("%sfile-%d", parent, i), 1, 16)
+		assert.Nil(op.Write(path, content), "write must succeed")
+		paths = append(paths, path)
+	}
+
+	obs, err := op.ListWith(parent, opendal.ListStartAfter(paths[4]))
+	assert.Nil(err)
+	defer obs.Close()
+	var actual []string
+	for obs.Next() {
+		actual = append(actual, obs.Entry().Path())
+	}
+	assert.Nil(obs.Error())
+
+	assert.Equal(paths[5:], actual)
+}
+
+func testListWithRecursiveStartAfter(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+
+	var paths []string
+	for _, name := range []string{"a", "b/x", "b/y", "c/z", "d"} {
+		path, content, _ := fixture.NewFileWithRange(parent+name, 1, 16)
+		assert.Nil(op.Write(path, content), "write must succeed")
+		paths = append(paths, path)
+	}
+	fixture.PushPath(parent + "b/")
+	fixture.PushPath(parent + "c/")
+
+	obs, err := op.ListWith(parent, opendal.ListRecursive(), opendal.ListStartAfter(parent+"b/x"))
+	assert.Nil(err)
+	defer obs.Close()
+	var actual []string
+	for obs.Next() {
+		actual = append(actual, obs.Entry().Path())
+	}
+	assert.Nil(obs.Error())
+
+	assert.Equal([]string{parent + "b/y", parent + "c/", parent + "c/z", parent + "d"}, actual)
+}
+
+func testListWithMetakeyMode(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+	dir := fixture.PushPath(fmt.Sprintf("%s%s/", parent, uuid.NewString()))
+	path, content, _ := fixture.NewFileWithPath(fmt.Sprintf("%s%s", parent, uuid.NewString()))
+
+	assert.Nil(op.CreateDir(dir), "create must succeed")
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	obs, err := op.ListWith(parent, opendal.ListMetakey(opendal.MetakeyMode))
+	assert.Nil(err)
+	defer obs.Close()
+
+	var count int
+	for obs.Next() {
+		entry := obs.Entry()
+		meta := entry.Metadata()
+		assert.NotNil(meta)
+		switch entry.Path() {
+		case dir:
+			assert.True(meta.IsDir())
+		case path:
+			assert.True(meta.IsFile())
+		}
+		count++
+	}
+	assert.Nil(obs.Error())
+	assert.Equal(2, count)
+}
+
+func testListWithMetakeyContentLength(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	parent := fixture.NewDirPath()
+	path, content, size := fixture.NewFileWithPath(fmt.Sprintf("%s%s", parent, uuid.NewString()))
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	obs, err := op.ListWith(parent, opendal.ListMetakey(opendal.MetakeyMode|opendal.MetakeyContentLength))
+	assert.Nil(err)
+	defer obs.Close()
+
+	var found bool
+	for obs.Next() {
+		entry := obs.Entry()
+		if entry.Path() != path {
+			continue
+		}
+		meta := entry.Metadata()
+		assert.True(meta.IsFile())
+		assert.Equal(uint64(size), meta.ContentLength())
+		found = true
+	}
+	assert.Nil(obs.Error())
+	assert.True(found, "file must be found in list")
+}
+
+func testListClosed(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	lister, err := op.List("/")
+	assert.Nil(err)
+	assert.Nil(lister.Close())
+	assert.ErrorIs(lister.Close(), os.ErrClosed, "second close must not free the lister again")
+	assert.False(lister.Next())
+	assert.ErrorIs(lister.Error(), os.ErrClosed)
+}
@@ -2,6 +2,9 @@ package opendal
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strings"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
@@ -64,8 +67,10 @@ func (op *Operator) Check() (err error) {
 //
 // # Notes
 //
-//  1. List is a wrapper around the C-binding function `opendal_operator_list`. Recursive listing is not currently supported.
-//  2. Returned entries do not include metadata information. Use op.Stat to fetch metadata for individual entries.
+//  1. List is a wrapper around the C-binding function `opendal_operator_list`. For recursive listing, use ListWith.
+//  2. Returned entries only carry the metadata supplied by the listing, which is just the
+//     mode unless the C binding exports `opendal_entry_metadata`. To get other fields for
+//     every entry, use ListWith with ListMetakey.
 //
 // # Example
 //
@@ -77,11 +82,6 @@ func (op *Operator) Check() (err error) {
 //
 //		for lister.Next() {
 //			entry := lister.Entry()
-//			if err := entry.Error(); err != nil {
-//				log.Printf("Error processing entry: %v", err)
-//				continue
-//			}
-//
 //			meta, err := op.Stat(entry.Path())
 //			if err != nil {
 //				log.Printf("Error fetching metadata for %s: %v", entry.Path(), err)
@@ -94,20 +94,160 @@ func (op *Operator) Check() (err error) {
 //			fmt.Printf("Is Directory: %v, Is File: %v\n", meta.IsDir(), meta.IsFile())
 //			fmt.Println("---")
 //		}
+//		if err := lister.Error(); err != nil {
+//			log.Fatal(err)
+//		}
 //	}
 //
 // Note: This example assumes proper error handling and import statements.
 func (op *Operator) List(path string) (*Lister, error) {
-	list := getFFI[operatorList](op.ctx, symOperatorList)
-	inner, err := list(op.inner, path)
-	if err != nil {
-		return nil, err
+	return op.ListContext(context.Background(), path)
+}
+
+// ListContext is like List but honors the deadline and cancellation of ctx.
+//
+// The returned Lister keeps ctx: once ctx is done, Next returns false and
+// Error returns an error wrapping ctx.Err().
+func (op *Operator) ListContext(ctx context.Context, path string) (*Lister, error) {
+	l, err := interceptValue(op, ctx, &Call{Operation: "list", Path: path}, func(ctx context.Context) (*Lister, error) {
+		return op.listWith(ctx, path, newListOptions(nil))
+	})
+	if err == nil {
+		l.intercepted = op.intercepting(ctx)
+	}
+	return l, err
+}
+
+// ListWith returns a Lister to iterate over entries under the given path with the given options.
+//
+// The C binding has no options-based listing, so the options are applied on the Go side
+// on top of `opendal_operator_list`.
+//
+// # Parameters
+//
+//   - path: The starting path for listing entries.
+//   - opts: Options such as ListRecursive, ListStartAfter or ListMetakey.
+//
+// # Returns
+//
+//   - *Lister: A new Lister instance for iterating over entries.
+//   - error: An error if the listing operation fails, or nil if successful.
+//
+// # Notes
+//
+//   - With ListRecursive, directories are walked one at a time and returned before their
+//     contents.
+//   - ListRecursive and ListStartAfter need the backend to list each directory in
+//     lexicographical order, so that the entries come out sorted and the last path seen
+//     can be used to resume. If an entry arrives out of order, Next stops with an error
+//     with code opendal.CodeUnexpected instead of returning an incomplete listing.
+//   - With ListMetakey, fields that the listing can't supply are fetched with a Stat per
+//     entry, which costs one request per entry on object stores.
+//
+// # Example
+//
+//	func exampleListWith(op *opendal.Operator, checkpoint string) {
+//		lister, err := op.ListWith("data/",
+//			opendal.ListRecursive(),
+//			opendal.ListStartAfter(checkpoint),
+//		)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		defer lister.Close()
+//
+//		for lister.Next() {
+//			checkpoint = lister.Entry().Path()
+//			fmt.Println(checkpoint)
+//		}
+//		if err := lister.Error(); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) ListWith(path string, opts ...ListOption) (*Lister, error) {
+	return op.ListWithContext(context.Background(), path, opts...)
+}
+
+// ListWithContext is like ListWith but honors the deadline and cancellation of ctx.
+func (op *Operator) ListWithContext(ctx context.Context, path string, opts ...ListOption) (*Lister, error) {
+	l, err := interceptValue(op, ctx, &Call{Operation: "list_with", Path: path, Args: []any{opts}}, func(ctx context.Context) (*Lister, error) {
+		return op.listWith(ctx, path, newListOptions(opts))
+	})
+	if err == nil {
+		l.intercepted = op.intercepting(ctx)
 	}
-	lister := &Lister{
-		inner: inner,
-		ctx:   op.ctx,
+	return l, err
+}
+
+func (op *Operator) listWith(ctx context.Context, path string, o *listOptions) (*Lister, error) {
+	list := op.syms.operatorList
+	if list == nil {
+		return nil, errUnsupported(symOperatorList)
+	}
+	return callContext(ctx, "list", func() (*Lister, error) {
+		inner, err := list(op.inner, path)
+		if err != nil {
+			return nil, err
+		}
+		lister := &Lister{
+			inner:      inner,
+			op:         op,
+			callCtx:    ctx,
+			path:       path,
+			recursive:  o.recursive,
+			startAfter: o.startAfter,
+			sorted:     o.recursive || o.startAfter != "",
+			metakey:    o.metakey,
+		}
+		return lister, nil
+	})
+}
+
+// ListOption configures ListWith.
+type ListOption func(o *listOptions)
+
+// ListRecursive lists all entries under the path, including those in subdirectories.
+func ListRecursive() ListOption {
+	return func(o *listOptions) {
+		o.recursive = true
 	}
-	return lister, nil
+}
+
+// ListMetakey requests the given metadata fields for every entry, so that
+// Entry.Metadata can be used instead of calling Stat for each entry.
+//
+// The fields are taken from the listing response when the C binding exports
+// `opendal_entry_metadata`. Otherwise, any field but MetakeyMode, which is always
+// available, is fetched with a Stat of each entry.
+func ListMetakey(keys Metakey) ListOption {
+	return func(o *listOptions) {
+		o.metakey |= keys
+	}
+}
+
+// ListStartAfter only returns entries whose path sorts after key.
+//
+// It can be used to resume a listing from the last path seen.
+func ListStartAfter(key string) ListOption {
+	return func(o *listOptions) {
+		o.startAfter = key
+	}
+}
+
+type listOptions struct {
+	recursive  bool
+	startAfter string
+	metakey    Metakey
+}
+
+func newListOptions(opts []ListOption) *listOptions {
+	o := &listOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
 }
 
 // Lister provides an mechanism for listing entries at a specified path.
@@ -115,10 +255,6 @@ func (op *Operator) List(path string) (*Lister, error) {
 // Lister is a wrapper around the C-binding function `opendal_operator_list`. It allows
 // for efficient iteration over entries in a storage system.
 //
-// # Limitations
-//
-//   - The current implementation does not support the `list_with` functionality.
-//
 // # Usage
 //
 // Lister should be used in conjunction with its Next() and Entry() methods to
@@ -143,17 +279,46 @@ func (op *Operator) List(path string) (*Lister, error) {
 //		fmt.Println(entry.Name())
 //	}
 type Lister struct {
-	inner *opendalLister
-	ctx   context.Context
-	entry *Entry
-	err   error
+	inner   *opendalLister
+	op      *Operator
+	callCtx context.Context
+	path    string
+	entry   *Entry
+	err     error
+
+	// recursive and startAfter are the ListWith options, applied on the Go side.
+	// parents holds the listers of the directories being walked.
+	recursive  bool
+	startAfter string
+	parents    []*opendalLister
+
+	// sorted is set if the options rely on each directory being listed in
+	// lexicographical order. last holds the last path returned by the lister of
+	// each directory being walked, the current one at the end.
+	sorted bool
+	last   []string
+
+	metakey Metakey
+	closed  bool
+
+	// intercepted is set if Next goes through the interceptors of op.
+	intercepted bool
 }
 
 // This method implements the io.Closer interface. It should be called when
 // the Lister is no longer needed to ensure proper resource cleanup.
+// Calling Close again returns os.ErrClosed.
 func (l *Lister) Close() error {
-	free := getFFI[listerFree](l.ctx, symListerFree)
+	if l.closed {
+		return os.ErrClosed
+	}
+	l.closed = true
+	free := l.op.syms.listerFree
 	free(l.inner)
+	for _, parent := range l.parents {
+		free(parent)
+	}
+	l.parents = nil
 
 	return nil
 }
@@ -199,20 +364,122 @@ func (l *Lister) Error() error {
 //		fmt.Println(entry.Name())
 //	}
 func (l *Lister) Next() bool {
-	next := getFFI[listerNext](l.ctx, symListerNext)
-	inner, err := next(l.inner)
-	if inner == nil || err != nil {
+	if !l.intercepted {
+		ok := l.next()
+		l.err = annotate(l.err, &Call{Operation: "lister.next", Path: l.path})
+		return ok
+	}
+	var ok bool
+	err := l.op.invoke(l.callCtx, &Call{Operation: "lister.next", Path: l.path}, func(context.Context) error {
+		ok = l.next()
+		return l.err
+	})
+	if err != nil {
 		l.err = err
 		l.entry = nil
 		return false
 	}
+	return ok
+}
 
-	entry := newEntry(l.ctx, inner)
+func (l *Lister) next() bool {
+	l.err = nil
+	if l.closed {
+		l.err = os.ErrClosed
+		l.entry = nil
+		return false
+	}
+	if err := l.callCtx.Err(); err != nil {
+		l.err = contextError("list", err)
+		l.entry = nil
+		return false
+	}
+	next := l.op.syms.listerNext
+	for {
+		inner, err := next(l.inner)
+		if err != nil {
+			l.err = err
+			l.entry = nil
+			return false
+		}
+		if inner == nil {
+			if len(l.parents) == 0 {
+				l.entry = nil
+				return false
+			}
+			free := l.op.syms.listerFree
+			free(l.inner)
+			l.inner = l.parents[len(l.parents)-1]
+			l.parents = l.parents[:len(l.parents)-1]
+			if l.sorted {
+				l.last = l.last[:len(l.last)-1]
+			}
+			continue
+		}
+
+		entry := newEntry(l.op.syms, inner)
+		if l.sorted && !l.inOrder(entry.path) {
+			return false
+		}
+
+		if l.recursive && strings.HasSuffix(entry.path, "/") && l.walksInto(entry.path) {
+			list := l.op.syms.operatorList
+			child, err := list(l.op.inner, entry.path)
+			if err != nil {
+				l.err = err
+				l.entry = nil
+				return false
+			}
+			l.parents = append(l.parents, l.inner)
+			l.inner = child
+			l.last = append(l.last, "")
+		}
+		if l.startAfter != "" && entry.path <= l.startAfter {
+			continue
+		}
+		if entry.metadata == nil && l.metakey&^MetakeyMode != 0 {
+			meta, err := l.op.StatContext(l.callCtx, entry.path)
+			if err != nil {
+				l.err = err
+				l.entry = nil
+				return false
+			}
+			entry.metadata = meta
+		}
+		if entry.metadata == nil {
+			entry.metadata = newModeMetadata(entry.path)
+		}
 
-	l.entry = entry
+		l.entry = entry
+		return true
+	}
+}
+
+// inOrder records path as the last entry of the current directory, and reports
+// whether it sorts after the previous one. Otherwise, it sets the error of the Lister.
+func (l *Lister) inOrder(path string) bool {
+	if len(l.last) == 0 {
+		l.last = append(l.last, "")
+	}
+	last := &l.last[len(l.last)-1]
+	if *last != "" && path <= *last {
+		l.err = &Error{
+			code:    CodeUnexpected,
+			message: fmt.Sprintf("entry %q is listed after %q, recursion and start-after need entries in lexicographical order", path, *last),
+		}
+		l.entry = nil
+		return false
+	}
+	*last = path
 	return true
 }
 
+// walksInto reports whether the emulated recursive listing has to descend into dir,
+// that is, whether dir may contain entries sorting after startAfter.
+func (l *Lister) walksInto(dir string) bool {
+	return l.startAfter == "" || dir > l.startAfter || strings.HasPrefix(l.startAfter, dir)
+}
+
 // Entry returns the current Entry in the list.
 // Returns nil if there are no more entries
 func (l *Lister) Entry() *Entry {
@@ -222,12 +489,8 @@ func (l *Lister) Entry() *Entry {
 // Entry represents a path and its associated metadata as returned by Lister.
 //
 // An Entry provides basic information about a file or directory encountered
-// during a list operation. It contains the path of the item and minimal metadata.
-//
-// # Limitations
-//
-// The Entry itself does not contain comprehensive metadata. For detailed
-// metadata information, use the op.Stat() method with the Entry's path.
+// during a list operation. It contains the path of the item and the metadata
+// supplied by the listing.
 //
 // # Usage
 //
@@ -241,36 +504,50 @@ func (l *Lister) Entry() *Entry {
 //
 // # Fetching Detailed Metadata
 //
-// To obtain comprehensive metadata for an Entry, use op.Stat():
+// To obtain specific metadata fields for every Entry, request them with ListMetakey.
+// They are taken from the listing if the C binding exports `opendal_entry_metadata`,
+// and fetched with a Stat of each entry otherwise.
 //
-//	meta, err := op.Stat(entry.Path())
+//	lister, err := op.ListWith("path/to/list",
+//		opendal.ListMetakey(opendal.MetakeyContentLength|opendal.MetakeyLastModified),
+//	)
 //	if err != nil {
-//		log.Printf("Error fetching metadata: %v", err)
-//		return
+//		log.Fatal(err)
+//	}
+//	for lister.Next() {
+//		meta := lister.Entry().Metadata()
+//		fmt.Printf("Size: %d, Last Modified: %s\n", meta.ContentLength(), meta.LastModified())
 //	}
-//	fmt.Printf("Size: %d, Last Modified: %s\n", meta.ContentLength(), meta.LastModified())
 //
 // # Methods
 //
 // Entry provides methods to access basic information:
 //   - Path(): Returns the full path of the entry.
 //   - Name(): Returns the name of the entry (last component of the path).
+//   - Metadata(): Returns the metadata of the entry.
 type Entry struct {
-	name string
-	path string
+	name     string
+	path     string
+	metadata *Metadata
 }
 
-func newEntry(ctx context.Context, inner *opendalEntry) *Entry {
-	name := getFFI[entryName](ctx, symEntryName)
-	path := getFFI[entryPath](ctx, symEntryPath)
-	free := getFFI[entryFree](ctx, symEntryFree)
+func newEntry(syms *symbols, inner *opendalEntry) *Entry {
+	name := syms.entryName
+	path := syms.entryPath
+	free := syms.entryFree
 
 	defer free(inner)
 
-	return &Entry{
+	entry := &Entry{
 		name: name(inner),
 		path: path(inner),
 	}
+	if metadata := syms.entryMetadata; metadata != nil {
+		if meta := metadata(inner); meta != nil {
+			entry.metadata = newMetadata(syms, meta)
+		}
+	}
+	return entry
 }
 
 // Name returns the last component of the entry's path.
@@ -283,15 +560,25 @@ func (e *Entry) Path() string {
 	return e.path
 }
 
+// Metadata returns the metadata of the entry.
+//
+// Only the fields requested with ListMetakey are guaranteed to be populated;
+// IsFile and IsDir are always available. The fields are fetched by Lister.Next,
+// so Entry.Metadata itself never calls Stat.
+func (e *Entry) Metadata() *Metadata {
+	return e.metadata
+}
+
 const symOperatorList = "opendal_operator_list"
 
 type operatorList func(op *opendalOperator, path string) (*opendalLister, error)
 
 var withOperatorList = withFFI(ffiOpts{
-	sym:    symOperatorList,
-	rType:  &typeResultList,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorList {
+	sym:      symOperatorList,
+	rType:    &typeResultList,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorList {
 	return func(op *opendalOperator, path string) (*opendalLister, error) {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -304,7 +591,7 @@ var withOperatorList = withFFI(ffiOpts{
 			unsafe.Pointer(&bytePath),
 		)
 		if result.err != nil {
-			return nil, parseError(ctx, result.err)
+			return nil, parseError(syms, result.err)
 		}
 		return result.lister, nil
 	}
@@ -318,7 +605,7 @@ var withListerFree = withFFI(ffiOpts{
 	sym:    symListerFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) listerFree {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) listerFree {
 	return func(l *opendalLister) {
 		ffiCall(
 			nil,
@@ -335,7 +622,7 @@ var withListerNext = withFFI(ffiOpts{
 	sym:    symListerNext,
 	rType:  &typeResultListerNext,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) listerNext {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) listerNext {
 	return func(l *opendalLister) (*opendalEntry, error) {
 		var result opendalResultListerNext
 		ffiCall(
@@ -343,7 +630,7 @@ var withListerNext = withFFI(ffiOpts{
 			unsafe.Pointer(&l),
 		)
 		if result.err != nil {
-			return nil, parseError(ctx, result.err)
+			return nil, parseError(syms, result.err)
 		}
 		return result.entry, nil
 	}
@@ -357,7 +644,7 @@ var withEntryFree = withFFI(ffiOpts{
 	sym:    symEntryFree,
 	rType:  &ffi.TypePointer,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) entryFree {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) entryFree {
 	return func(e *opendalEntry) {
 		ffiCall(
 			nil,
@@ -374,7 +661,7 @@ var withEntryName = withFFI(ffiOpts{
 	sym:    symEntryName,
 	rType:  &ffi.TypePointer,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) entryName {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) entryName {
 	return func(e *opendalEntry) string {
 		var bytePtr *byte
 		ffiCall(
@@ -393,7 +680,7 @@ var withEntryPath = withFFI(ffiOpts{
 	sym:    symEntryPath,
 	rType:  &ffi.TypePointer,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) entryPath {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) entryPath {
 	return func(e *opendalEntry) string {
 		var bytePtr *byte
 		ffiCall(
@@ -403,3 +690,23 @@ var withEntryPath = withFFI(ffiOpts{
 		return unix.BytePtrToString(bytePtr)
 	}
 })
+
+const symEntryMetadata = "opendal_entry_metadata"
+
+type entryMetadata func(e *opendalEntry) *opendalMetadata
+
+var withEntryMetadata = withFFI(ffiOpts{
+	sym:      symEntryMetadata,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) entryMetadata {
+	return func(e *opendalEntry) *opendalMetadata {
+		var meta *opendalMetadata
+		ffiCall(
+			unsafe.Pointer(&meta),
+			unsafe.Pointer(&e),
+		)
+		return meta
+	}
+})
@@ -1,29 +1,109 @@
 package opendal
 
 import (
-	"context"
+	"maps"
+	"strings"
 	"time"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
+	"golang.org/x/sys/unix"
 )
 
 // Metadata represents essential information about a file or directory.
 //
 // This struct contains basic attributes commonly used in file systems
-// and object storage systems.
+// and object storage systems. Attributes such as ETag or ContentType are
+// optional: their accessors report whether the backend supplied them.
 type Metadata struct {
 	contentLength uint64
 	isFile        bool
 	isDir         bool
 	lastModified  time.Time
+
+	etag               *string
+	contentType        *string
+	contentMD5         *string
+	contentDisposition *string
+	cacheControl       *string
+	version            *string
+	userMetadata       map[string]string
+}
+
+// Metakey is a set of metadata fields, combined with bitwise OR.
+//
+// It is used by ListMetakey to request the fields a Lister should populate
+// in Entry.Metadata.
+type Metakey uint32
+
+const (
+	// MetakeyComplete requests all metadata fields.
+	MetakeyComplete Metakey = 1 << iota
+	// MetakeyMode requests whether the entry is a file or a directory.
+	MetakeyMode
+	// MetakeyContentLength requests the content length.
+	MetakeyContentLength
+	// MetakeyLastModified requests the last modified time.
+	MetakeyLastModified
+	// MetakeyETag requests the ETag.
+	MetakeyETag
+	// MetakeyContentType requests the content type.
+	MetakeyContentType
+	// MetakeyContentMD5 requests the MD5 digest of the content.
+	MetakeyContentMD5
+	// MetakeyContentDisposition requests the content disposition.
+	MetakeyContentDisposition
+	// MetakeyCacheControl requests the cache control.
+	MetakeyCacheControl
+	// MetakeyVersion requests the version.
+	MetakeyVersion
+	// MetakeyUserMetadata requests the user-defined metadata.
+	MetakeyUserMetadata
+)
+
+var metakeyNames = []struct {
+	key  Metakey
+	name string
+}{
+	{MetakeyComplete, "complete"},
+	{MetakeyMode, "mode"},
+	{MetakeyContentLength, "content_length"},
+	{MetakeyLastModified, "last_modified"},
+	{MetakeyETag, "etag"},
+	{MetakeyContentType, "content_type"},
+	{MetakeyContentMD5, "content_md5"},
+	{MetakeyContentDisposition, "content_disposition"},
+	{MetakeyCacheControl, "cache_control"},
+	{MetakeyVersion, "version"},
+	{MetakeyUserMetadata, "user_metadata"},
 }
 
-func newMetadata(ctx context.Context, inner *opendalMetadata) *Metadata {
-	getLength := getFFI[metaContentLength](ctx, symMetadataContentLength)
-	isFile := getFFI[metaIsFile](ctx, symMetadataIsFile)
-	isDir := getFFI[metaIsDir](ctx, symMetadataIsDir)
-	getLastModified := getFFI[metaLastModified](ctx, symMetadataLastModified)
+// String returns the comma-separated names of the fields in the set.
+func (k Metakey) String() string {
+	var names []string
+	for _, m := range metakeyNames {
+		if k&m.key != 0 {
+			names = append(names, m.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// newModeMetadata creates metadata that only knows whether path is a file
+// or a directory, based on its trailing slash.
+func newModeMetadata(path string) *Metadata {
+	isDir := strings.HasSuffix(path, "/")
+	return &Metadata{
+		isFile: !isDir,
+		isDir:  isDir,
+	}
+}
+
+func newMetadata(syms *symbols, inner *opendalMetadata) *Metadata {
+	getLength := syms.metadataContentLength
+	isFile := syms.metadataIsFile
+	isDir := syms.metadataIsDir
+	getLastModified := syms.metadataLastModified
 
 	var lastModified time.Time
 	ms := getLastModified(inner)
@@ -31,17 +111,55 @@ func newMetadata(ctx context.Context, inner *opendalMetadata) *Metadata {
 		lastModified = time.UnixMilli(ms)
 	}
 
-	free := getFFI[metaFree](ctx, symMetadataFree)
+	free := syms.metadataFree
 	defer free(inner)
 
 	return &Metadata{
-		contentLength: getLength(inner),
-		isFile:        isFile(inner),
-		isDir:         isDir(inner),
-		lastModified:  lastModified,
+		contentLength:      getLength(inner),
+		isFile:             isFile(inner),
+		isDir:              isDir(inner),
+		lastModified:       lastModified,
+		etag:               getMetaString(syms.metadataETag, inner),
+		contentType:        getMetaString(syms.metadataContentType, inner),
+		contentMD5:         getMetaString(syms.metadataContentMD5, inner),
+		contentDisposition: getMetaString(syms.metadataContentDisposition, inner),
+		cacheControl:       getMetaString(syms.metadataCacheControl, inner),
+		version:            getMetaString(syms.metadataVersion, inner),
+		userMetadata:       getUserMetadata(syms, inner),
 	}
 }
 
+// getMetaString reads an optional string field, returning nil if either the
+// loaded C binding or the backend doesn't supply it.
+func getMetaString(get metaString, inner *opendalMetadata) *string {
+	if get == nil {
+		return nil
+	}
+	return get(inner)
+}
+
+func getUserMetadata(syms *symbols, inner *opendalMetadata) map[string]string {
+	getLen := syms.metadataUserMetadataLen
+	if getLen == nil {
+		return nil
+	}
+	n := getLen(inner)
+	if n == 0 {
+		return nil
+	}
+
+	getKey := syms.metadataUserMetadataKey
+	getValue := syms.metadataUserMetadataValue
+	if getKey == nil || getValue == nil {
+		return nil
+	}
+	userMetadata := make(map[string]string, n)
+	for i := range n {
+		userMetadata[getKey(inner, i)] = getValue(inner, i)
+	}
+	return userMetadata
+}
+
 // ContentLength returns the size of the file in bytes.
 //
 // For directories, this value may not be meaningful and could be zero.
@@ -66,6 +184,71 @@ func (m *Metadata) LastModified() time.Time {
 	return m.lastModified
 }
 
+// ETag returns the ETag of the file.
+//
+// The boolean is false if the backend doesn't supply an ETag, or if the loaded
+// C binding does not export `opendal_metadata_etag`.
+func (m *Metadata) ETag() (string, bool) {
+	return optionalString(m.etag)
+}
+
+// ContentType returns the media type of the file, such as "text/plain".
+//
+// The boolean is false if the backend doesn't supply a content type, or if the loaded
+// C binding does not export `opendal_metadata_content_type`.
+func (m *Metadata) ContentType() (string, bool) {
+	return optionalString(m.contentType)
+}
+
+// ContentMD5 returns the MD5 digest of the file content, as reported by the backend.
+//
+// The boolean is false if the backend doesn't supply a digest, or if the loaded
+// C binding does not export `opendal_metadata_content_md5`.
+func (m *Metadata) ContentMD5() (string, bool) {
+	return optionalString(m.contentMD5)
+}
+
+// ContentDisposition returns the Content-Disposition of the file.
+//
+// The boolean is false if the backend doesn't supply a content disposition, or if the loaded
+// C binding does not export `opendal_metadata_content_disposition`.
+func (m *Metadata) ContentDisposition() (string, bool) {
+	return optionalString(m.contentDisposition)
+}
+
+// CacheControl returns the Cache-Control of the file.
+//
+// The boolean is false if the backend doesn't supply a cache control, or if the loaded
+// C binding does not export `opendal_metadata_cache_control`.
+func (m *Metadata) CacheControl() (string, bool) {
+	return optionalString(m.cacheControl)
+}
+
+// Version returns the version of the file on versioned backends.
+//
+// The boolean is false if the backend doesn't supply a version, or if the loaded
+// C binding does not export `opendal_metadata_version`.
+func (m *Metadata) Version() (string, bool) {
+	return optionalString(m.version)
+}
+
+// UserMetadata returns the user-defined metadata of the file.
+//
+// It returns nil if the file has no user-defined metadata, if the backend doesn't
+// supply it, or if the loaded C binding does not export the
+// `opendal_metadata_user_metadata_*` functions. The returned map is a copy and may
+// be modified.
+func (m *Metadata) UserMetadata() map[string]string {
+	return maps.Clone(m.userMetadata)
+}
+
+func optionalString(s *string) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	return *s, true
+}
+
 type metaContentLength func(m *opendalMetadata) uint64
 
 const symMetadataContentLength = "opendal_metadata_content_length"
@@ -74,7 +257,7 @@ var withMetaContentLength = withFFI(ffiOpts{
 	sym:    symMetadataContentLength,
 	rType:  &ffi.TypeUint64,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaContentLength {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaContentLength {
 	return func(m *opendalMetadata) uint64 {
 		var length uint64
 		ffiCall(
@@ -93,7 +276,7 @@ var withMetaIsFile = withFFI(ffiOpts{
 	sym:    symMetadataIsFile,
 	rType:  &ffi.TypeUint8,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaIsFile {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaIsFile {
 	return func(m *opendalMetadata) bool {
 		var result uint8
 		ffiCall(
@@ -112,7 +295,7 @@ var withMetaIsDir = withFFI(ffiOpts{
 	sym:    symMetadataIsDir,
 	rType:  &ffi.TypeUint8,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaIsDir {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaIsDir {
 	return func(m *opendalMetadata) bool {
 		var result uint8
 		ffiCall(
@@ -131,7 +314,7 @@ var withMetaLastModified = withFFI(ffiOpts{
 	sym:    symMetadataLastModified,
 	rType:  &ffi.TypeSint64,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaLastModified {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaLastModified {
 	return func(m *opendalMetadata) int64 {
 		var result int64
 		ffiCall(
@@ -150,7 +333,7 @@ var withMetaFree = withFFI(ffiOpts{
 	sym:    symMetadataFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaFree {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaFree {
 	return func(m *opendalMetadata) {
 		ffiCall(
 			nil,
@@ -158,3 +341,102 @@ var withMetaFree = withFFI(ffiOpts{
 		)
 	}
 })
+
+// metaString reads an optional string field of the metadata. The C binding
+// returns NULL if the field is absent, otherwise a string borrowed from the
+// metadata that stays valid until opendal_metadata_free.
+type metaString func(m *opendalMetadata) *string
+
+const (
+	symMetadataETag               = "opendal_metadata_etag"
+	symMetadataContentType        = "opendal_metadata_content_type"
+	symMetadataContentMD5         = "opendal_metadata_content_md5"
+	symMetadataContentDisposition = "opendal_metadata_content_disposition"
+	symMetadataCacheControl       = "opendal_metadata_cache_control"
+	symMetadataVersion            = "opendal_metadata_version"
+)
+
+func withMetaString(sym string) ffiLoader[metaString] {
+	return withFFI(ffiOpts{
+		sym:      sym,
+		rType:    &ffi.TypePointer,
+		aTypes:   []*ffi.Type{&ffi.TypePointer},
+		optional: true,
+	}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaString {
+		return func(m *opendalMetadata) *string {
+			var bytePtr *byte
+			ffiCall(
+				unsafe.Pointer(&bytePtr),
+				unsafe.Pointer(&m),
+			)
+			if bytePtr == nil {
+				return nil
+			}
+			value := unix.BytePtrToString(bytePtr)
+			return &value
+		}
+	})
+}
+
+var (
+	withMetaETag               = withMetaString(symMetadataETag)
+	withMetaContentType        = withMetaString(symMetadataContentType)
+	withMetaContentMD5         = withMetaString(symMetadataContentMD5)
+	withMetaContentDisposition = withMetaString(symMetadataContentDisposition)
+	withMetaCacheControl       = withMetaString(symMetadataCacheControl)
+	withMetaVersion            = withMetaString(symMetadataVersion)
+)
+
+// metaUserMetadataLen returns the number of user metadata pairs, a size_t in C.
+type metaUserMetadataLen func(m *opendalMetadata) uint64
+
+const symMetadataUserMetadataLen = "opendal_metadata_user_metadata_len"
+
+var withMetaUserMetadataLen = withFFI(ffiOpts{
+	sym:      symMetadataUserMetadataLen,
+	rType:    &ffi.TypeUint64,
+	aTypes:   []*ffi.Type{&ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaUserMetadataLen {
+	return func(m *opendalMetadata) uint64 {
+		var length uint64
+		ffiCall(
+			unsafe.Pointer(&length),
+			unsafe.Pointer(&m),
+		)
+		return length
+	}
+})
+
+// metaUserMetadataAt reads the key or the value of the i-th user metadata pair.
+// The index is a size_t in C.
+type metaUserMetadataAt func(m *opendalMetadata, i uint64) string
+
+const (
+	symMetadataUserMetadataKey   = "opendal_metadata_user_metadata_key"
+	symMetadataUserMetadataValue = "opendal_metadata_user_metadata_value"
+)
+
+func withMetaUserMetadataAt(sym string) ffiLoader[metaUserMetadataAt] {
+	return withFFI(ffiOpts{
+		sym:      sym,
+		rType:    &ffi.TypePointer,
+		aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypeUint64},
+		optional: true,
+	}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) metaUserMetadataAt {
+		return func(m *opendalMetadata, i uint64) string {
+			var bytePtr *byte
+			ffiCall(
+				unsafe.Pointer(&bytePtr),
+				unsafe.Pointer(&m),
+				unsafe.Pointer(&i),
+			)
+			return unix.BytePtrToString(bytePtr)
+		}
+	})
+}
+
+var (
+	withMetaUserMetadataKey   = withMetaUserMetadataAt(symMetadataUserMetadataKey)
+	withMetaUserMetadataValue = withMetaUserMetadataAt(symMetadataUserMetadataValue)
+)
@@ -0,0 +1,66 @@
+package opendal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMetadataOptionalFields(t *testing.T) {
+	assert := require.New(t)
+
+	etag := `"5d41402abc4b2a76b9719d911017c592"`
+	contentType := "text/plain"
+	f := &fakeFile{
+		content:      []byte("hello"),
+		etag:         &etag,
+		contentType:  &contentType,
+		userMetadata: [][2]string{{"location", "everywhere"}, {"owner", "web"}},
+	}
+
+	meta := newMetadata(f.symbols(), nil)
+	assert.Equal(1, f.freed, "metadata must be freed once")
+	assert.Equal(uint64(5), meta.ContentLength())
+	assert.True(meta.IsFile())
+	assert.True(meta.LastModified().IsZero(), "-1 means the backend has no last modified time")
+
+	value, ok := meta.ETag()
+	assert.True(ok)
+	assert.Equal(etag, value)
+	value, ok = meta.ContentType()
+	assert.True(ok)
+	assert.Equal(contentType, value)
+
+	// Exported, but absent from this file.
+	_, ok = meta.Version()
+	assert.False(ok)
+	// Not exported by the C binding.
+	_, ok = meta.CacheControl()
+	assert.False(ok)
+	_, ok = meta.ContentMD5()
+	assert.False(ok)
+
+	userMetadata := meta.UserMetadata()
+	assert.Equal(map[string]string{"location": "everywhere", "owner": "web"}, userMetadata)
+	userMetadata["owner"] = "changed"
+	assert.Equal("web", meta.UserMetadata()["owner"], "user metadata must be returned as a copy")
+}
+
+func TestNewMetadataWithoutUserMetadataValues(t *testing.T) {
+	assert := require.New(t)
+
+	f := &fakeFile{
+		lastModified: 1700000000000,
+		userMetadata: [][2]string{{"owner", "web"}},
+	}
+	syms := f.symbols()
+	// The length alone is not enough to read the pairs.
+	syms.metadataUserMetadataKey = nil
+	syms.metadataUserMetadataValue = nil
+
+	meta := newMetadata(syms, nil)
+	assert.Equal(int64(1700000000000), meta.LastModified().UnixMilli())
+	assert.Nil(meta.UserMetadata())
+	_, ok := meta.ETag()
+	assert.False(ok)
+}
@@ -39,7 +39,7 @@
 package opendal
 
 import (
-	"context"
+	"sync"
 )
 
 // Scheme defines the interface for storage scheme implementations.
@@ -129,10 +129,14 @@ type OperatorOptions map[string]string
 //
 // Refer to the individual method documentation for detailed usage information.
 type Operator struct {
-	ctx    context.Context
-	cancel context.CancelFunc
+	syms   *symbols
+	cancel func()
+	// closeOnce is shared by the operators returned by Layer.
+	closeOnce *sync.Once
 
 	inner *opendalOperator
+
+	interceptors []Interceptor
 }
 
 // NewOperator creates and initializes a new Operator for the specified storage scheme.
@@ -152,30 +156,37 @@ func NewOperator(scheme Scheme, opts OperatorOptions) (op *Operator, err error)
 		return
 	}
 
-	ctx, cancel, err := contextWithFFIs(scheme.Path())
+	syms, cancel, err := loadSymbols(scheme.Path())
 	if err != nil {
 		return
 	}
+	// The library must stay loaded until options are freed.
+	defer func() {
+		if err != nil {
+			cancel()
+		}
+	}()
 
-	options := getFFI[operatorOptionsNew](ctx, symOperatorOptionsNew)()
-	setOptions := getFFI[operatorOptionsSet](ctx, symOperatorOptionSet)
-	optionsFree := getFFI[operatorOptionsFree](ctx, symOperatorOptionsFree)
-
-	for key, value := range opts {
-		setOptions(options, key, value)
+	options, err := newOperatorOptions(syms, opts)
+	if err != nil {
+		return
 	}
-	defer optionsFree(options)
+	defer syms.operatorOptionsFree(options)
 
-	inner, err := getFFI[operatorNew](ctx, symOperatorNew)(scheme, options)
+	inner, err := syms.operatorNew(scheme, options)
 	if err != nil {
-		cancel()
+		return
+	}
+	if err = checkCapability(scheme.Path(), syms, inner); err != nil {
+		syms.operatorFree(inner)
 		return
 	}
 
 	op = &Operator{
-		inner:  inner,
-		ctx:    ctx,
-		cancel: cancel,
+		inner:     inner,
+		syms:      syms,
+		cancel:    cancel,
+		closeOnce: &sync.Once{},
 	}
 
 	return
@@ -187,8 +198,10 @@ func NewOperator(scheme Scheme, opts OperatorOptions) (op *Operator, err error)
 // to ensure proper cleanup of underlying resources.
 //
 // Note: It's recommended to use defer op.Close() immediately after creating an Operator.
+// Calling Close more than once has no effect.
 func (op *Operator) Close() {
-	free := getFFI[operatorFree]
-	free(op.ctx, symOperatorFree)(op.inner)
-	op.cancel()
+	op.closeOnce.Do(func() {
+		op.syms.operatorFree(op.inner)
+		op.cancel()
+	})
 }
@@ -2,6 +2,7 @@ package opendal_test
 
 import (
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -36,13 +37,23 @@ func TestBehavior(t *testing.T) {
 
 	var tests []behaviorTest
 
+	tests = append(tests, testsContext(cap)...)
 	tests = append(tests, testsCopy(cap)...)
 	tests = append(tests, testsCreateDir(cap)...)
 	tests = append(tests, testsDelete(cap)...)
+	tests = append(tests, testsError(cap)...)
+	tests = append(tests, testsFS(cap)...)
+	tests = append(tests, testsHandler(cap)...)
+	tests = append(tests, testsLayer(cap)...)
 	tests = append(tests, testsList(cap)...)
+	tests = append(tests, testsOperator(cap)...)
+	tests = append(tests, testsPresign(cap)...)
 	tests = append(tests, testsRead(cap)...)
 	tests = append(tests, testsRename(cap)...)
+	tests = append(tests, testsRetry(cap)...)
 	tests = append(tests, testsStat(cap)...)
+	tests = append(tests, testsURI(cap)...)
+	tests = append(tests, testsWritableFS(cap)...)
 	tests = append(tests, testsWrite(cap)...)
 
 	fixture := newFixture(op)
@@ -96,6 +107,15 @@ func newOperator() (op *opendal.Operator, closeFunc func(), err error) {
 		return
 	}
 
+	op, err = opendal.NewOperator(scheme, envOptions(scheme))
+	if err != nil {
+		err = fmt.Errorf("create operator must succeed: %s", err)
+	}
+
+	return
+}
+
+func envOptions(scheme opendal.Scheme) opendal.OperatorOptions {
 	prefix := fmt.Sprintf("OPENDAL_%s_", strings.ToUpper(scheme.Name()))
 
 	opts := opendal.OperatorOptions{}
@@ -111,17 +131,15 @@ func newOperator() (op *opendal.Operator, closeFunc func(), err error) {
 		}
 		opts[strings.ToLower(strings.TrimPrefix(key, prefix))] = value
 	}
-
-	op, err = opendal.NewOperator(scheme, opts)
-	if err != nil {
-		err = fmt.Errorf("create operator must succeed: %s", err)
-	}
-
-	return
+	return opts
 }
 
 func assertErrorCode(err error) opendal.ErrorCode {
-	return err.(*opendal.Error).Code()
+	var e *opendal.Error
+	if !errors.As(err, &e) {
+		return -1
+	}
+	return e.Code()
 }
 
 func genBytesWithRange(min, max uint) ([]byte, uint) {
@@ -195,9 +213,7 @@ func (f *fixture) Cleanup(assert *require.Assertions) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
-	for _, path := range f.paths {
-		assert.Nil(f.op.Delete(path), "delete must succeed: %s", path)
-	}
+	assert.Nil(f.op.DeleteMany(f.paths), "delete must succeed")
 }
 
 func (f *fixture) PushPath(path string) string {
@@ -41,8 +41,23 @@ import (
 //
 // Note: This example assumes proper error handling and import statements.
 func (op *Operator) Copy(src, dest string) error {
-	cp := getFFI[operatorCopy](op.ctx, symOperatorCopy)
-	return cp(op.inner, src, dest)
+	return op.CopyContext(context.Background(), src, dest)
+}
+
+// CopyContext is like Copy but honors the deadline and cancellation of ctx.
+//
+// If ctx is done before the copy starts, CopyContext returns an error wrapping
+// ctx.Err(). Once started, the copy cannot be aborted and runs to completion.
+func (op *Operator) CopyContext(ctx context.Context, src, dest string) error {
+	return op.intercept(ctx, &Call{Operation: "copy", Path: src, Args: []any{dest}}, func(ctx context.Context) error {
+		cp := op.syms.operatorCopy
+		if cp == nil {
+			return errUnsupported(symOperatorCopy)
+		}
+		return callContextErr(ctx, "copy", func() error {
+			return cp(op.inner, src, dest)
+		})
+	})
 }
 
 // Rename changes the name or location of a file from the source path to the destination path.
@@ -77,8 +92,23 @@ func (op *Operator) Copy(src, dest string) error {
 //
 // Note: This example assumes proper error handling and import statements.
 func (op *Operator) Rename(src, dest string) error {
-	rename := getFFI[operatorRename](op.ctx, symOperatorRename)
-	return rename(op.inner, src, dest)
+	return op.RenameContext(context.Background(), src, dest)
+}
+
+// RenameContext is like Rename but honors the deadline and cancellation of ctx.
+//
+// If ctx is done before the rename starts, RenameContext returns an error wrapping
+// ctx.Err(). Once started, the rename cannot be aborted and runs to completion.
+func (op *Operator) RenameContext(ctx context.Context, src, dest string) error {
+	return op.intercept(ctx, &Call{Operation: "rename", Path: src, Args: []any{dest}}, func(ctx context.Context) error {
+		rename := op.syms.operatorRename
+		if rename == nil {
+			return errUnsupported(symOperatorRename)
+		}
+		return callContextErr(ctx, "rename", func() error {
+			return rename(op.inner, src, dest)
+		})
+	})
 }
 
 const symOperatorNew = "opendal_operator_new"
@@ -89,7 +119,7 @@ var withOperatorNew = withFFI(ffiOpts{
 	sym:    symOperatorNew,
 	rType:  &typeResultOperatorNew,
 	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorNew {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorNew {
 	return func(scheme Scheme, opts *operatorOptions) (op *opendalOperator, err error) {
 		var byteName *byte
 		byteName, err = unix.BytePtrFromString(scheme.Name())
@@ -103,7 +133,7 @@ var withOperatorNew = withFFI(ffiOpts{
 			unsafe.Pointer(&opts),
 		)
 		if result.error != nil {
-			err = parseError(ctx, result.error)
+			err = parseError(syms, result.error)
 			return
 		}
 		op = result.op
@@ -119,7 +149,7 @@ var withOperatorFree = withFFI(ffiOpts{
 	sym:    symOperatorFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(_ context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorFree {
+}, func(_ *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorFree {
 	return func(op *opendalOperator) {
 		ffiCall(
 			nil,
@@ -132,6 +162,27 @@ type operatorOptions struct {
 	inner uintptr
 }
 
+// newOperatorOptions copies opts into a C options map. The caller must
+// release it with operatorOptionsFree.
+func newOperatorOptions(syms *symbols, opts OperatorOptions) (*operatorOptions, error) {
+	options := syms.operatorOptionsNew()
+	setOptions := syms.operatorOptionSet
+	for key, value := range opts {
+		if err := setOptions(options, key, value); err != nil {
+			syms.operatorOptionsFree(options)
+			return nil, err
+		}
+	}
+	return options, nil
+}
+
+// setOption sets key in opts unless value is empty.
+func setOption(opts OperatorOptions, key, value string) {
+	if value != "" {
+		opts[key] = value
+	}
+}
+
 const symOperatorOptionsNew = "opendal_operator_options_new"
 
 type operatorOptionsNew func() (opts *operatorOptions)
@@ -139,7 +190,7 @@ type operatorOptionsNew func() (opts *operatorOptions)
 var withOperatorOptionsNew = withFFI(ffiOpts{
 	sym:   symOperatorOptionsNew,
 	rType: &ffi.TypePointer,
-}, func(_ context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorOptionsNew {
+}, func(_ *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorOptionsNew {
 	return func() (opts *operatorOptions) {
 		ffiCall(unsafe.Pointer(&opts))
 		return
@@ -154,7 +205,7 @@ var withOperatorOptionsSet = withFFI(ffiOpts{
 	sym:    symOperatorOptionSet,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
-}, func(_ context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorOptionsSet {
+}, func(_ *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorOptionsSet {
 	return func(opts *operatorOptions, key, value string) (err error) {
 		var (
 			byteKey   *byte
@@ -186,7 +237,7 @@ var withOperatorOptionsFree = withFFI(ffiOpts{
 	sym:    symOperatorOptionsFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(_ context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorOptionsFree {
+}, func(_ *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorOptionsFree {
 	return func(opts *operatorOptions) {
 		ffiCall(
 			nil,
@@ -200,10 +251,11 @@ const symOperatorCopy = "opendal_operator_copy"
 type operatorCopy func(op *opendalOperator, src, dest string) (err error)
 
 var withOperatorCopy = withFFI(ffiOpts{
-	sym:    symOperatorCopy,
-	rType:  &ffi.TypePointer,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorCopy {
+	sym:      symOperatorCopy,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorCopy {
 	return func(op *opendalOperator, src, dest string) (err error) {
 		var (
 			byteSrc  *byte
@@ -224,7 +276,7 @@ var withOperatorCopy = withFFI(ffiOpts{
 			unsafe.Pointer(&byteSrc),
 			unsafe.Pointer(&byteDest),
 		)
-		return parseError(ctx, e)
+		return parseError(syms, e)
 	}
 })
 
@@ -233,10 +285,11 @@ const symOperatorRename = "opendal_operator_rename"
 type operatorRename func(op *opendalOperator, src, dest string) (err error)
 
 var withOperatorRename = withFFI(ffiOpts{
-	sym:    symOperatorRename,
-	rType:  &ffi.TypePointer,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorRename {
+	sym:      symOperatorRename,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorRename {
 	return func(op *opendalOperator, src, dest string) (err error) {
 		var (
 			byteSrc  *byte
@@ -257,7 +310,7 @@ var withOperatorRename = withFFI(ffiOpts{
 			unsafe.Pointer(&byteSrc),
 			unsafe.Pointer(&byteDest),
 		)
-		return parseError(ctx, e)
+		return parseError(syms, e)
 	}
 })
 
@@ -269,7 +322,7 @@ var withBytesFree = withFFI(ffiOpts{
 	sym:    symBytesFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(_ context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) bytesFree {
+}, func(_ *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) bytesFree {
 	return func(b *opendalBytes) {
 		ffiCall(
 			nil,
@@ -1,7 +1,6 @@
 package opendal
 
 import (
-	"context"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
@@ -16,15 +15,15 @@ import (
 // Returns:
 //   - *OperatorInfo: A pointer to an OperatorInfo struct containing the Operator's metadata.
 func (op *Operator) Info() *OperatorInfo {
-	newInfo := getFFI[operatorInfoNew](op.ctx, symOperatorInfoNew)
+	newInfo := op.syms.operatorInfoNew
 	inner := newInfo(op.inner)
-	getFullCap := getFFI[operatorInfoGetFullCapability](op.ctx, symOperatorInfoGetFullCapability)
-	getNativeCap := getFFI[operatorInfoGetNativeCapability](op.ctx, symOperatorInfoGetNativeCapability)
-	getScheme := getFFI[operatorInfoGetScheme](op.ctx, symOperatorInfoGetScheme)
-	getRoot := getFFI[operatorInfoGetRoot](op.ctx, symOperatorInfoGetRoot)
-	getName := getFFI[operatorInfoGetName](op.ctx, symOperatorInfoGetName)
+	getFullCap := op.syms.operatorInfoGetFullCapability
+	getNativeCap := op.syms.operatorInfoGetNativeCapability
+	getScheme := op.syms.operatorInfoGetScheme
+	getRoot := op.syms.operatorInfoGetRoot
+	getName := op.syms.operatorInfoGetName
 
-	free := getFFI[operatorInfoFree](op.ctx, symOperatorInfoFree)
+	free := op.syms.operatorInfoFree
 	defer free(inner)
 
 	return &OperatorInfo{
@@ -238,7 +237,7 @@ var withOperatorInfoNew = withFFI(ffiOpts{
 	sym:    symOperatorInfoNew,
 	rType:  &ffi.TypePointer,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoNew {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoNew {
 	return func(op *opendalOperator) *opendalOperatorInfo {
 		var result *opendalOperatorInfo
 		ffiCall(
@@ -257,7 +256,7 @@ var withOperatorInfoFree = withFFI(ffiOpts{
 	sym:    symOperatorInfoFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoFree {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoFree {
 	return func(info *opendalOperatorInfo) {
 		ffiCall(
 			nil,
@@ -274,7 +273,7 @@ var withOperatorInfoGetFullCapability = withFFI(ffiOpts{
 	sym:    symOperatorInfoGetFullCapability,
 	rType:  &typeCapability,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetFullCapability {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetFullCapability {
 	return func(info *opendalOperatorInfo) *opendalCapability {
 		var cap opendalCapability
 		ffiCall(
@@ -293,7 +292,7 @@ var withOperatorInfoGetNativeCapability = withFFI(ffiOpts{
 	sym:    symOperatorInfoGetNativeCapability,
 	rType:  &typeCapability,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetNativeCapability {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetNativeCapability {
 	return func(info *opendalOperatorInfo) *opendalCapability {
 		var cap opendalCapability
 		ffiCall(
@@ -312,7 +311,7 @@ var withOperatorInfoGetScheme = withFFI(ffiOpts{
 	sym:    symOperatorInfoGetScheme,
 	rType:  &ffi.TypePointer,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetScheme {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetScheme {
 	return func(info *opendalOperatorInfo) string {
 		var bytePtr *byte
 		ffiCall(
@@ -331,7 +330,7 @@ var withOperatorInfoGetRoot = withFFI(ffiOpts{
 	sym:    symOperatorInfoGetRoot,
 	rType:  &ffi.TypePointer,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetRoot {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetRoot {
 	return func(info *opendalOperatorInfo) string {
 		var bytePtr *byte
 		ffiCall(
@@ -350,7 +349,7 @@ var withOperatorInfoGetName = withFFI(ffiOpts{
 	sym:    symOperatorInfoGetName,
 	rType:  &ffi.TypePointer,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetName {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorInfoGetName {
 	return func(info *opendalOperatorInfo) string {
 		var bytePtr *byte
 		ffiCall(
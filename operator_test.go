@@ -0,0 +1,243 @@
+package opendal_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsOperator(cap *opendal.Capability) []behaviorTest {
+	if !cap.Read() || !cap.Write() {
+		return nil
+	}
+	return []behaviorTest{
+		testOperatorSharedLibrary,
+		testOperatorCloseTwice,
+		testOperatorConcurrentOpenClose,
+		testOperatorMultipleSchemes,
+	}
+}
+
+func testScheme() opendal.Scheme {
+	for _, s := range schemes {
+		if s.Name() == os.Getenv("OPENDAL_TEST") {
+			return s
+		}
+	}
+	return nil
+}
+
+func testOperatorSharedLibrary(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	scheme := testScheme()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			other, err := opendal.NewOperator(scheme, envOptions(scheme))
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			other.Info()
+			other.Close()
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		assert.Nil(err, "create operator must succeed")
+	}
+
+	// The library is still loaded for op after the other operators are closed.
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+	data, err := op.Read(path)
+	assert.Nil(err, "read must succeed")
+	assert.Equal(content, data)
+}
+
+// copiedScheme loads a private copy of the library of a scheme, so that the
+// library is not shared with the operators of the other tests.
+type copiedScheme struct {
+	opendal.Scheme
+	path string
+}
+
+func newCopiedScheme(scheme opendal.Scheme, dir string) (*copiedScheme, error) {
+	src, err := os.Open(scheme.Path())
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	dest, err := os.CreateTemp(dir, "libopendal_c_copy*.so")
+	if err != nil {
+		return nil, err
+	}
+	defer dest.Close()
+	if _, err = io.Copy(dest, src); err != nil {
+		return nil, err
+	}
+	return &copiedScheme{Scheme: scheme, path: dest.Name()}, nil
+}
+
+func (s *copiedScheme) Path() string {
+	return s.path
+}
+
+func (s *copiedScheme) LoadOnce() error {
+	return nil
+}
+
+func testOperatorConcurrentOpenClose(assert *require.Assertions, _ *opendal.Operator, fixture *fixture) {
+	scheme, err := newCopiedScheme(testScheme(), os.TempDir())
+	assert.Nil(err)
+	defer os.Remove(scheme.Path())
+
+	// Operators are opened and closed concurrently, so the library is released by
+	// the last operator while others are being opened.
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 4 {
+				errs[i] = func() error {
+					other, err := opendal.NewOperator(scheme, envOptions(scheme))
+					if err != nil {
+						return err
+					}
+					defer other.Close()
+					path, content, _ := fixture.NewFile()
+					if err := other.Write(path, content); err != nil {
+						return err
+					}
+					defer other.Delete(path)
+					data, err := other.Read(path)
+					if err != nil {
+						return err
+					}
+					if !bytes.Equal(data, content) {
+						return fmt.Errorf("read %d bytes from %s, want %d", len(data), path, len(content))
+					}
+					return nil
+				}()
+				if errs[i] != nil {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		assert.Nil(err, "open, write, read and close must succeed")
+	}
+}
+
+// localOptions are the options of schemes that can be created without a backend.
+var localOptions = map[string]opendal.OperatorOptions{
+	"aliyun_drive": {"access_token": "test", "drive_type": "resource"},
+	"memory":       {},
+}
+
+func testOperatorMultipleSchemes(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	// A private copy of the library is loaded as a second library next to the one
+	// of op, so both must resolve their own symbols to perform I/O.
+	copied, err := newCopiedScheme(testScheme(), os.TempDir())
+	assert.Nil(err)
+	defer os.Remove(copied.Path())
+	other, err := opendal.NewOperator(copied, envOptions(copied))
+	assert.Nil(err, "create operator must succeed")
+	defer other.Close()
+
+	// A library of another service is loaded as well, when one can be created
+	// without a backend.
+	for _, s := range schemes {
+		if _, ok := localOptions[s.Name()]; !ok || s.Name() == testScheme().Name() {
+			continue
+		}
+		assert.Nil(s.LoadOnce())
+		defer os.Remove(s.Path())
+		another, err := opendal.NewOperator(s, localOptions[s.Name()])
+		assert.Nil(err, "create operator must succeed")
+		defer another.Close()
+		assert.Equal(s.Name(), another.Info().GetScheme())
+		break
+	}
+
+	for _, o := range []*opendal.Operator{op, other} {
+		assert.Equal(testScheme().Name(), o.Info().GetScheme())
+
+		path, content, _ := fixture.NewFile()
+		assert.Nil(o.Write(path, content), "write must succeed")
+		data, err := o.Read(path)
+		assert.Nil(err, "read must succeed")
+		assert.Equal(content, data)
+		meta, err := o.Stat(path)
+		assert.Nil(err, "stat must succeed")
+		assert.Equal(uint64(len(content)), meta.ContentLength())
+		assert.Nil(o.Delete(path))
+	}
+}
+
+func testOperatorCloseTwice(assert *require.Assertions, _ *opendal.Operator, _ *fixture) {
+	scheme := testScheme()
+
+	other, err := opendal.NewOperator(scheme, envOptions(scheme))
+	assert.Nil(err, "create operator must succeed")
+	layered := other.Layer()
+
+	other.Close()
+	layered.Close()
+	other.Close()
+}
+
+// libraryScheme loads the shared library at its path as is.
+type libraryScheme string
+
+func (s libraryScheme) Name() string {
+	return "library"
+}
+
+func (s libraryScheme) Path() string {
+	return string(s)
+}
+
+func (s libraryScheme) LoadOnce() error {
+	return nil
+}
+
+// systemLibraries are shared libraries found on every system of their platform,
+// none of which is a C binding of OpenDAL.
+var systemLibraries = map[string]string{
+	"darwin":  "/usr/lib/libSystem.B.dylib",
+	"freebsd": "libc.so.7",
+	"linux":   "libc.so.6",
+}
+
+func TestNewOperatorIncompatibleLibrary(t *testing.T) {
+	assert := require.New(t)
+
+	path, ok := systemLibraries[runtime.GOOS]
+	if !ok {
+		t.Skipf("no system library known on %s", runtime.GOOS)
+	}
+	_, err := opendal.NewOperator(libraryScheme(path), opendal.OperatorOptions{})
+	assert.NotNil(err, "a library other than the C binding must be rejected")
+	var e *opendal.Error
+	if !errors.As(err, &e) {
+		t.Skipf("%s can't be loaded: %v", path, err)
+	}
+	assert.Equal(opendal.CodeUnsupported, e.Code())
+	assert.Contains(err.Error(), "incompatible C binding "+path)
+}
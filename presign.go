@@ -0,0 +1,330 @@
+package opendal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+	"unsafe"
+
+	"github.com/jupiterrider/ffi"
+	"golang.org/x/sys/unix"
+)
+
+// PresignRead generates a presigned HTTP request to read the file at the specified path.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_presign_read`.
+//
+// # Parameters
+//
+//   - path: The path of the file to read.
+//   - expire: How long the presigned request stays valid.
+//
+// # Returns
+//
+//   - *PresignedRequest: The method, URL and headers of the presigned request.
+//   - error: An error if presigning fails, or nil if successful.
+//
+// # Notes
+//
+//   - Requires Capability.Presign and Capability.PresignRead, otherwise an error with code
+//     opendal.CodeUnsupported is returned without calling the C binding.
+//   - If the loaded C binding does not export the presign functions, an error with code
+//     opendal.CodeUnsupported is returned as well.
+//   - The request can be sent by any HTTP client, such as a browser, without credentials.
+//
+// # Example
+//
+//	func examplePresignRead(op *opendal.Operator) {
+//		req, err := op.PresignRead("path/to/file", time.Hour)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("%s %s\n", req.Method(), req.URL())
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) PresignRead(path string, expire time.Duration) (*PresignedRequest, error) {
+	return op.PresignReadContext(context.Background(), path, expire)
+}
+
+// PresignReadContext is like PresignRead but honors the deadline and cancellation of ctx.
+func (op *Operator) PresignReadContext(ctx context.Context, path string, expire time.Duration) (*PresignedRequest, error) {
+	return op.presign(ctx, "presign_read", symOperatorPresignRead, op.syms.operatorPresignRead, (*Capability).PresignRead, path, expire)
+}
+
+// PresignWrite generates a presigned HTTP request to write the file at the specified path.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_presign_write`.
+// The body of the request becomes the content of the file.
+//
+// Requires Capability.Presign and Capability.PresignWrite, otherwise an error with code
+// opendal.CodeUnsupported is returned.
+func (op *Operator) PresignWrite(path string, expire time.Duration) (*PresignedRequest, error) {
+	return op.PresignWriteContext(context.Background(), path, expire)
+}
+
+// PresignWriteContext is like PresignWrite but honors the deadline and cancellation of ctx.
+func (op *Operator) PresignWriteContext(ctx context.Context, path string, expire time.Duration) (*PresignedRequest, error) {
+	return op.presign(ctx, "presign_write", symOperatorPresignWrite, op.syms.operatorPresignWrite, (*Capability).PresignWrite, path, expire)
+}
+
+// PresignStat generates a presigned HTTP request to fetch the metadata of the specified path.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_presign_stat`.
+//
+// Requires Capability.Presign and Capability.PresignStat, otherwise an error with code
+// opendal.CodeUnsupported is returned.
+func (op *Operator) PresignStat(path string, expire time.Duration) (*PresignedRequest, error) {
+	return op.PresignStatContext(context.Background(), path, expire)
+}
+
+// PresignStatContext is like PresignStat but honors the deadline and cancellation of ctx.
+func (op *Operator) PresignStatContext(ctx context.Context, path string, expire time.Duration) (*PresignedRequest, error) {
+	return op.presign(ctx, "presign_stat", symOperatorPresignStat, op.syms.operatorPresignStat, (*Capability).PresignStat, path, expire)
+}
+
+func (op *Operator) presign(ctx context.Context, name, sym string, presign operatorPresign, capable func(*Capability) bool, path string, expire time.Duration) (*PresignedRequest, error) {
+	call := &Call{Operation: name, Path: path, Args: []any{expire}}
+	return interceptValue(op, ctx, call, func(ctx context.Context) (*PresignedRequest, error) {
+		info := op.Info()
+		if capability := info.GetFullCapability(); !capability.Presign() || !capable(capability) {
+			return nil, &Error{
+				code:    CodeUnsupported,
+				message: fmt.Sprintf("%s is not supported by service %s", name, info.GetScheme()),
+			}
+		}
+		if presign == nil {
+			return nil, errUnsupported(sym)
+		}
+		if err := checkPresignedRequest(op.syms); err != nil {
+			return nil, err
+		}
+		return callContext(ctx, name, func() (*PresignedRequest, error) {
+			req, err := presign(op.inner, path, expire)
+			if err != nil {
+				return nil, err
+			}
+			return newPresignedRequest(op.syms, req), nil
+		})
+	})
+}
+
+// PresignedRequest is an HTTP request signed in advance, which grants temporary
+// access to a path without sharing credentials.
+type PresignedRequest struct {
+	method string
+	url    string
+	header http.Header
+}
+
+// checkPresignedRequest fails if the loaded C binding can't read or free a presigned
+// request, so that none is created only to be leaked.
+func checkPresignedRequest(syms *symbols) error {
+	switch {
+	case syms.presignedRequestMethod == nil:
+		return errUnsupported(symPresignedRequestMethod)
+	case syms.presignedRequestURI == nil:
+		return errUnsupported(symPresignedRequestURI)
+	case syms.presignedRequestHeadersLen == nil:
+		return errUnsupported(symPresignedRequestHeadersLen)
+	case syms.presignedRequestHeaderKey == nil:
+		return errUnsupported(symPresignedRequestHeaderKey)
+	case syms.presignedRequestHeaderValue == nil:
+		return errUnsupported(symPresignedRequestHeaderValue)
+	case syms.presignedRequestFree == nil:
+		return errUnsupported(symPresignedRequestFree)
+	}
+	return nil
+}
+
+// newPresignedRequest copies inner into a PresignedRequest and frees it. The symbols
+// must have been checked with checkPresignedRequest.
+func newPresignedRequest(syms *symbols, inner *opendalPresignedRequest) *PresignedRequest {
+	method := syms.presignedRequestMethod
+	uri := syms.presignedRequestURI
+	headersLen := syms.presignedRequestHeadersLen
+	headerKey := syms.presignedRequestHeaderKey
+	headerValue := syms.presignedRequestHeaderValue
+	free := syms.presignedRequestFree
+
+	defer free(inner)
+
+	header := http.Header{}
+	for i := range headersLen(inner) {
+		header.Add(headerKey(inner, i), headerValue(inner, i))
+	}
+	return &PresignedRequest{
+		method: method(inner),
+		url:    uri(inner),
+		header: header,
+	}
+}
+
+// Method returns the HTTP method of the request, such as "GET" or "PUT".
+func (r *PresignedRequest) Method() string {
+	return r.method
+}
+
+// URL returns the signed URL of the request.
+func (r *PresignedRequest) URL() string {
+	return r.url
+}
+
+// Header returns the headers that must be sent along with the request.
+func (r *PresignedRequest) Header() http.Header {
+	return r.header.Clone()
+}
+
+type opendalPresignedRequest struct {
+	inner uintptr
+}
+
+type resultPresign struct {
+	req   *opendalPresignedRequest
+	error *opendalError
+}
+
+type operatorPresign func(op *opendalOperator, path string, expire time.Duration) (*opendalPresignedRequest, error)
+
+const (
+	symOperatorPresignRead  = "opendal_operator_presign_read"
+	symOperatorPresignWrite = "opendal_operator_presign_write"
+	symOperatorPresignStat  = "opendal_operator_presign_stat"
+)
+
+func withOperatorPresign(sym string) ffiLoader[operatorPresign] {
+	return withFFI(ffiOpts{
+		sym:      sym,
+		rType:    &typeResultPresign,
+		aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypeUint64},
+		optional: true,
+	}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorPresign {
+		return func(op *opendalOperator, path string, expire time.Duration) (*opendalPresignedRequest, error) {
+			bytePath, err := unix.BytePtrFromString(path)
+			if err != nil {
+				return nil, err
+			}
+			expireSecs := uint64(expire / time.Second)
+			var result resultPresign
+			ffiCall(
+				unsafe.Pointer(&result),
+				unsafe.Pointer(&op),
+				unsafe.Pointer(&bytePath),
+				unsafe.Pointer(&expireSecs),
+			)
+			if result.error != nil {
+				return nil, parseError(syms, result.error)
+			}
+			return result.req, nil
+		}
+	})
+}
+
+var (
+	withOperatorPresignRead  = withOperatorPresign(symOperatorPresignRead)
+	withOperatorPresignWrite = withOperatorPresign(symOperatorPresignWrite)
+	withOperatorPresignStat  = withOperatorPresign(symOperatorPresignStat)
+)
+
+type presignedRequestString func(req *opendalPresignedRequest) string
+
+const (
+	symPresignedRequestMethod = "opendal_presigned_request_method"
+	symPresignedRequestURI    = "opendal_presigned_request_uri"
+)
+
+func withPresignedRequestString(sym string) ffiLoader[presignedRequestString] {
+	return withFFI(ffiOpts{
+		sym:      sym,
+		rType:    &ffi.TypePointer,
+		aTypes:   []*ffi.Type{&ffi.TypePointer},
+		optional: true,
+	}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) presignedRequestString {
+		return func(req *opendalPresignedRequest) string {
+			var bytePtr *byte
+			ffiCall(
+				unsafe.Pointer(&bytePtr),
+				unsafe.Pointer(&req),
+			)
+			return unix.BytePtrToString(bytePtr)
+		}
+	})
+}
+
+var (
+	withPresignedRequestMethod = withPresignedRequestString(symPresignedRequestMethod)
+	withPresignedRequestURI    = withPresignedRequestString(symPresignedRequestURI)
+)
+
+const symPresignedRequestHeadersLen = "opendal_presigned_request_headers_len"
+
+// presignedRequestHeadersLen returns the number of headers, a size_t in C.
+type presignedRequestHeadersLen func(req *opendalPresignedRequest) uint64
+
+var withPresignedRequestHeadersLen = withFFI(ffiOpts{
+	sym:      symPresignedRequestHeadersLen,
+	rType:    &ffi.TypeUint64,
+	aTypes:   []*ffi.Type{&ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) presignedRequestHeadersLen {
+	return func(req *opendalPresignedRequest) uint64 {
+		var length uint64
+		ffiCall(
+			unsafe.Pointer(&length),
+			unsafe.Pointer(&req),
+		)
+		return length
+	}
+})
+
+// presignedRequestHeaderAt reads the name or the value of the i-th header.
+// The index is a size_t in C.
+type presignedRequestHeaderAt func(req *opendalPresignedRequest, i uint64) string
+
+const (
+	symPresignedRequestHeaderKey   = "opendal_presigned_request_header_key"
+	symPresignedRequestHeaderValue = "opendal_presigned_request_header_value"
+)
+
+func withPresignedRequestHeaderAt(sym string) ffiLoader[presignedRequestHeaderAt] {
+	return withFFI(ffiOpts{
+		sym:      sym,
+		rType:    &ffi.TypePointer,
+		aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypeUint64},
+		optional: true,
+	}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) presignedRequestHeaderAt {
+		return func(req *opendalPresignedRequest, i uint64) string {
+			var bytePtr *byte
+			ffiCall(
+				unsafe.Pointer(&bytePtr),
+				unsafe.Pointer(&req),
+				unsafe.Pointer(&i),
+			)
+			return unix.BytePtrToString(bytePtr)
+		}
+	})
+}
+
+var (
+	withPresignedRequestHeaderKey   = withPresignedRequestHeaderAt(symPresignedRequestHeaderKey)
+	withPresignedRequestHeaderValue = withPresignedRequestHeaderAt(symPresignedRequestHeaderValue)
+)
+
+const symPresignedRequestFree = "opendal_presigned_request_free"
+
+type presignedRequestFree func(req *opendalPresignedRequest)
+
+var withPresignedRequestFree = withFFI(ffiOpts{
+	sym:      symPresignedRequestFree,
+	rType:    &ffi.TypeVoid,
+	aTypes:   []*ffi.Type{&ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) presignedRequestFree {
+	return func(req *opendalPresignedRequest) {
+		ffiCall(
+			nil,
+			unsafe.Pointer(&req),
+		)
+	}
+})
@@ -0,0 +1,44 @@
+package opendal
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPresignedRequest(t *testing.T) {
+	assert := require.New(t)
+
+	f := &fakeFile{
+		method: http.MethodPut,
+		uri:    "https://bucket.example.com/key?X-Amz-Signature=abc",
+		headers: [][2]string{
+			{"Host", "bucket.example.com"},
+			{"X-Amz-Meta-Tag", "a"},
+			{"X-Amz-Meta-Tag", "b"},
+		},
+	}
+	syms := f.symbols()
+	assert.Nil(checkPresignedRequest(syms))
+
+	req := newPresignedRequest(syms, nil)
+	assert.Equal(1, f.freed, "presigned request must be freed once")
+	assert.Equal(http.MethodPut, req.Method())
+	assert.Equal("https://bucket.example.com/key?X-Amz-Signature=abc", req.URL())
+	assert.Equal("bucket.example.com", req.Header().Get("Host"))
+	assert.Equal([]string{"a", "b"}, req.Header().Values("X-Amz-Meta-Tag"))
+
+	req.Header().Set("Host", "changed")
+	assert.Equal("bucket.example.com", req.Header().Get("Host"), "header must be returned as a copy")
+}
+
+func TestCheckPresignedRequest(t *testing.T) {
+	assert := require.New(t)
+
+	syms := (&fakeFile{}).symbols()
+	syms.presignedRequestHeadersLen = nil
+	err := checkPresignedRequest(syms)
+	assert.ErrorIs(err, ErrUnsupported)
+	assert.Contains(err.Error(), symPresignedRequestHeadersLen)
+}
@@ -0,0 +1,120 @@
+package opendal_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsPresign(cap *opendal.Capability) []behaviorTest {
+	if !cap.Presign() {
+		return []behaviorTest{
+			testPresignUnsupported,
+		}
+	}
+	return []behaviorTest{
+		testPresignRead,
+		testPresignWrite,
+		testPresignStat,
+	}
+}
+
+// presignSupported reports whether op can presign requests of the given kind. If the
+// service can't, presign must have failed with CodeUnsupported before calling the C
+// binding; if the C binding doesn't export the presign functions, the error must say
+// which one is missing.
+func presignSupported(assert *require.Assertions, capable bool, err error) bool {
+	if !capable {
+		assert.NotNil(err, "presign must fail without the capability")
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err))
+		return false
+	}
+	if err == nil || assertErrorCode(err) != opendal.CodeUnsupported {
+		return true
+	}
+	assert.Contains(err.Error(), "is not exported by the loaded C binding")
+	return false
+}
+
+// sendPresigned sends req with body over HTTP.
+func sendPresigned(assert *require.Assertions, req *opendal.PresignedRequest, body []byte) *http.Response {
+	httpReq, err := http.NewRequest(req.Method(), req.URL(), bytes.NewReader(body))
+	assert.Nil(err)
+	httpReq.Header = req.Header()
+	resp, err := http.DefaultClient.Do(httpReq)
+	assert.Nil(err, "presigned request must be sent")
+	return resp
+}
+
+func testPresignRead(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	req, err := op.PresignRead(path, time.Hour)
+	if !presignSupported(assert, op.Info().GetFullCapability().PresignRead(), err) {
+		return
+	}
+	assert.Nil(err, "presign read must succeed")
+	assert.Equal(http.MethodGet, req.Method())
+	assert.NotEmpty(req.URL())
+
+	resp := sendPresigned(assert, req, nil)
+	defer resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	assert.Nil(err)
+	assert.Equal(content, body, "the presigned request must read the file")
+}
+
+func testPresignWrite(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+
+	req, err := op.PresignWrite(path, time.Hour)
+	if !presignSupported(assert, op.Info().GetFullCapability().PresignWrite(), err) {
+		return
+	}
+	assert.Nil(err, "presign write must succeed")
+	assert.Equal(http.MethodPut, req.Method())
+	assert.NotEmpty(req.URL())
+
+	content := genFixedBytes(1024)
+	resp := sendPresigned(assert, req, content)
+	resp.Body.Close()
+	assert.Less(resp.StatusCode, 300, "the presigned request must write the file")
+
+	bs, err := op.Read(path)
+	assert.Nil(err)
+	assert.Equal(content, bs)
+}
+
+func testPresignStat(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	req, err := op.PresignStat(path, time.Hour)
+	if !presignSupported(assert, op.Info().GetFullCapability().PresignStat(), err) {
+		return
+	}
+	assert.Nil(err, "presign stat must succeed")
+	assert.Equal(http.MethodHead, req.Method())
+	assert.NotEmpty(req.URL())
+}
+
+func testPresignUnsupported(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+
+	for _, presign := range []func(string, time.Duration) (*opendal.PresignedRequest, error){
+		op.PresignRead,
+		op.PresignWrite,
+		op.PresignStat,
+	} {
+		_, err := presign(path, time.Hour)
+		assert.NotNil(err, "presign must fail without the capability")
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err))
+		assert.Contains(err.Error(), "is not supported by service")
+	}
+}
@@ -1,6 +1,11 @@
 package opendal_test
 
 import (
+	"bytes"
+	"io"
+	"os"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.yuchanns.xyz/opendal"
@@ -13,6 +18,22 @@ func testsRead(cap *opendal.Capability) []behaviorTest {
 	return []behaviorTest{
 		testReadFull,
 		testReader,
+		testReaderSeek,
+		testReaderReadAt,
+		testReaderClosed,
+		testReaderEOF,
+		testReaderWriteTo,
+		testReadWithRange,
+		testReadWithOffset,
+		testReadWithRangeNotSatisfied,
+		testReaderWithRange,
+		testReadWithIfMatch,
+		testReadWithIfNoneMatch,
+		testReadWithIfModifiedSince,
+		testReadWithIfUnmodifiedSince,
+		testReadInto,
+		testReadIntoShortBuffer,
+		testReadPooled,
 		testReadNotExist,
 		testReadWithDirPath,
 		testReadWithSpecialChars,
@@ -39,12 +60,275 @@ func testReader(assert *require.Assertions, op *opendal.Operator, fixture *fixtu
 	assert.Nil(err)
 	defer r.Close()
 	bs := make([]byte, size)
-	n, err := r.Read(bs)
+	n, err := io.ReadFull(r, bs)
 	assert.Nil(err)
 	assert.Equal(size, uint(n), "read size")
 	assert.Equal(content, bs[:n], "read content")
 }
 
+func testReaderSeek(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	r, err := op.Reader(path)
+	assert.Nil(err)
+	defer r.Close()
+
+	total, err := r.Size()
+	assert.Nil(err)
+	assert.Equal(int64(size), total, "reader size")
+
+	bs := make([]byte, 16)
+	n, err := r.Read(bs)
+	assert.Nil(err)
+	assert.Equal(content[:n], bs[:n], "read head")
+
+	offset := int64(size / 2)
+	pos, err := r.Seek(offset, io.SeekStart)
+	assert.Nil(err)
+	assert.Equal(offset, pos)
+	n, err = r.Read(bs)
+	assert.Nil(err)
+	assert.Equal(content[offset:offset+int64(n)], bs[:n], "read after seek start")
+
+	pos, err = r.Seek(-8, io.SeekEnd)
+	assert.Nil(err)
+	assert.Equal(int64(size)-8, pos)
+	n, err = r.Read(bs)
+	assert.Nil(err)
+	assert.Equal(8, n, "read to the end")
+	assert.Equal(content[size-8:], bs[:n], "read after seek end")
+
+	pos, err = r.Seek(-16, io.SeekCurrent)
+	assert.Nil(err)
+	assert.Equal(int64(size)-16, pos)
+	n, err = r.Read(bs)
+	assert.Nil(err)
+	assert.Equal(content[size-16:], bs[:n], "read after seek current")
+
+	_, err = r.Seek(-1, io.SeekStart)
+	assert.NotNil(err, "seek to negative position must fail")
+}
+
+func testReaderClosed(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	r, err := op.Reader(path)
+	assert.Nil(err)
+	assert.Nil(r.Close())
+
+	assert.ErrorIs(r.Close(), os.ErrClosed, "second close must not free the reader again")
+	_, err = r.Read(make([]byte, 1))
+	assert.ErrorIs(err, os.ErrClosed)
+	_, err = r.ReadAt(make([]byte, 1), 0)
+	assert.ErrorIs(err, os.ErrClosed)
+	_, err = r.Seek(0, io.SeekStart)
+	assert.ErrorIs(err, os.ErrClosed)
+	_, err = r.WriteTo(io.Discard)
+	assert.ErrorIs(err, os.ErrClosed)
+}
+
+func testReaderReadAt(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	r, err := op.Reader(path)
+	assert.Nil(err)
+	defer r.Close()
+
+	bs := make([]byte, 32)
+	offset := int64(size / 3)
+	n, err := r.ReadAt(bs, offset)
+	assert.Nil(err)
+	assert.Equal(len(bs), n)
+	assert.Equal(content[offset:offset+int64(n)], bs, "read at offset")
+
+	n, err = r.ReadAt(bs, int64(size)-10)
+	assert.Equal(io.EOF, err)
+	assert.Equal(10, n)
+	assert.Equal(content[size-10:], bs[:n], "read at tail")
+
+	bs = make([]byte, size)
+	n, err = io.ReadFull(r, bs)
+	assert.Nil(err)
+	assert.Equal(size, uint(n), "ReadAt must not move the read offset")
+	assert.Equal(content, bs)
+}
+
+func testReaderEOF(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	r, err := op.Reader(path)
+	assert.Nil(err)
+	defer r.Close()
+
+	bs, err := io.ReadAll(r)
+	assert.Nil(err)
+	assert.Equal(content, bs, "read all content")
+
+	n, err := r.Read(make([]byte, 16))
+	assert.Equal(0, n)
+	assert.Equal(io.EOF, err, "read at the end must return io.EOF")
+
+	_, err = r.Seek(int64(size)+16, io.SeekStart)
+	assert.Nil(err)
+	_, err = r.Read(make([]byte, 16))
+	assert.Equal(io.EOF, err, "read beyond the end must return io.EOF")
+
+	ranged, err := op.ReaderWith(path, opendal.ReadOffset(16), opendal.ReadLength(32))
+	assert.Nil(err)
+	defer ranged.Close()
+
+	bs, err = io.ReadAll(ranged)
+	assert.Nil(err)
+	assert.Equal(content[16:48], bs, "read all range")
+}
+
+func testReaderWriteTo(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	r, err := op.Reader(path)
+	assert.Nil(err)
+	defer r.Close()
+
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, r)
+	assert.Nil(err)
+	assert.Equal(int64(size), n)
+	assert.Equal(content, buf.Bytes(), "copy content")
+
+	offset, length := uint64(size/4), uint64(size/2)
+	ranged, err := op.ReaderWith(path, opendal.ReadOffset(offset), opendal.ReadLength(length))
+	assert.Nil(err)
+	defer ranged.Close()
+
+	buf.Reset()
+	n, err = ranged.WriteTo(&buf)
+	assert.Nil(err)
+	assert.Equal(int64(length), n)
+	assert.Equal(content[offset:offset+length], buf.Bytes(), "copy range")
+}
+
+func testReadWithRange(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	offset, length := uint64(size/4), uint64(size/2)
+	bs, err := op.ReadWith(path, opendal.ReadOffset(offset), opendal.ReadLength(length))
+	assert.Nil(err)
+	assert.Equal(length, uint64(len(bs)), "read size")
+	assert.Equal(content[offset:offset+length], bs, "read content")
+
+	bs, err = op.ReadWith(path, opendal.ReadLength(uint64(size)*2))
+	assert.Nil(err)
+	assert.Equal(content, bs, "length beyond the end must read the whole file")
+}
+
+func testReadWithOffset(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	offset := uint64(size - 32)
+	bs, err := op.ReadWith(path, opendal.ReadOffset(offset))
+	assert.Nil(err)
+	assert.Equal(content[offset:], bs, "read content")
+}
+
+func testReadWithRangeNotSatisfied(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 1, 1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	_, err := op.ReadWith(path, opendal.ReadOffset(uint64(size)+1))
+	assert.NotNil(err)
+	assert.Equal(opendal.CodeRangeNotSatisfied, assertErrorCode(err))
+}
+
+func testReaderWithRange(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	offset, length := int64(size/4), int64(size/2)
+	r, err := op.ReaderWith(path, opendal.ReadOffset(uint64(offset)), opendal.ReadLength(uint64(length)))
+	assert.Nil(err)
+	defer r.Close()
+
+	total, err := r.Size()
+	assert.Nil(err)
+	assert.Equal(length, total, "reader size must be the range length")
+
+	bs, err := io.ReadAll(r)
+	assert.Nil(err)
+	assert.Equal(content[offset:offset+length], bs, "read range")
+
+	pos, err := r.Seek(-8, io.SeekEnd)
+	assert.Nil(err)
+	assert.Equal(length-8, pos)
+	n, err := r.Read(bs)
+	assert.Nil(err)
+	assert.Equal(content[offset+length-8:offset+length], bs[:n], "read range tail")
+}
+
+func testReadInto(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	buf := make([]byte, size+16)
+	n, err := op.ReadInto(path, buf)
+	assert.Nil(err)
+	assert.Equal(size, uint(n), "read size")
+	assert.Equal(content, buf[:n], "read content")
+
+	buf = make([]byte, size)
+	n, err = op.ReadInto(path, buf)
+	assert.Nil(err, "a buffer of the file size must be large enough")
+	assert.Equal(content, buf[:n])
+}
+
+func testReadIntoShortBuffer(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFileWithRange(uuid.NewString(), 64, 4*1024*1024)
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	buf := make([]byte, size/2)
+	n, err := op.ReadInto(path, buf)
+	assert.Equal(io.ErrShortBuffer, err)
+	assert.Equal(len(buf), n)
+	assert.Equal(content[:n], buf, "read head")
+
+	_, err = op.ReadInto(fixture.NewFilePath(), buf)
+	assert.Equal(opendal.CodeNotFound, assertErrorCode(err))
+}
+
+func testReadPooled(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	for range 3 {
+		buf, err := op.ReadPooled(path)
+		assert.Nil(err)
+		assert.Equal(content, buf.Bytes(), "read content")
+		buf.Release()
+		buf.Release()
+	}
+
+	_, err := op.ReadPooled(fixture.NewFilePath())
+	assert.Equal(opendal.CodeNotFound, assertErrorCode(err))
+}
+
 func testReadNotExist(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
 	path := fixture.NewFilePath()
 
@@ -77,3 +361,91 @@ func testReadWithSpecialChars(assert *require.Assertions, op *opendal.Operator,
 	assert.Equal(size, uint(len(bs)))
 	assert.Equal(content, bs)
 }
+
+func testReadWithIfMatch(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	etag, ok := meta.ETag()
+	if !ok {
+		_, err = op.ReadWith(path, opendal.ReadIfMatch("\"invalid_etag\""))
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err), "conditions can't be checked without an etag")
+		return
+	}
+
+	_, err = op.ReadWith(path, opendal.ReadIfMatch("\"invalid_etag\""))
+	assert.Equal(opendal.CodeConditionNotMatch, assertErrorCode(err))
+
+	bs, err := op.ReadWith(path, opendal.ReadIfMatch(etag))
+	assert.Nil(err)
+	assert.Equal(content, bs)
+}
+
+func testReadWithIfNoneMatch(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	etag, ok := meta.ETag()
+	if !ok {
+		_, err = op.ReadWith(path, opendal.ReadIfNoneMatch("\"invalid_etag\""))
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err), "conditions can't be checked without an etag")
+		return
+	}
+
+	_, err = op.ReadWith(path, opendal.ReadIfNoneMatch(etag))
+	assert.Equal(opendal.CodeConditionNotMatch, assertErrorCode(err))
+
+	bs, err := op.ReadWith(path, opendal.ReadIfNoneMatch("\"invalid_etag\""))
+	assert.Nil(err)
+	assert.Equal(content, bs)
+}
+
+func testReadWithIfModifiedSince(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	modified := meta.LastModified()
+	if modified.IsZero() {
+		_, err = op.ReadWith(path, opendal.ReadIfModifiedSince(time.Now()))
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err), "conditions can't be checked without a last modified time")
+		return
+	}
+
+	_, err = op.ReadWith(path, opendal.ReadIfModifiedSince(modified))
+	assert.Equal(opendal.CodeConditionNotMatch, assertErrorCode(err))
+
+	bs, err := op.ReadWith(path, opendal.ReadIfModifiedSince(modified.Add(-time.Second)))
+	assert.Nil(err)
+	assert.Equal(content, bs)
+}
+
+func testReadWithIfUnmodifiedSince(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	modified := meta.LastModified()
+	if modified.IsZero() {
+		_, err = op.ReadWith(path, opendal.ReadIfUnmodifiedSince(time.Now()))
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err), "conditions can't be checked without a last modified time")
+		return
+	}
+
+	_, err = op.ReadWith(path, opendal.ReadIfUnmodifiedSince(modified.Add(-time.Second)))
+	assert.Equal(opendal.CodeConditionNotMatch, assertErrorCode(err))
+
+	bs, err := op.ReadWith(path, opendal.ReadIfUnmodifiedSince(modified))
+	assert.Nil(err)
+	assert.Equal(content, bs)
+}
@@ -2,7 +2,11 @@ package opendal
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"os"
+	"time"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
@@ -24,9 +28,9 @@ import (
 //
 // # Notes
 //
-//   - This implementation does not support the `read_with` functionality.
-//   - Read allocates a new byte slice internally. For more precise memory control
-//     or lazy reading, consider using the Reader() method instead.
+//   - To read a range, use ReadWith instead.
+//   - Read copies the contents into a new byte slice. To read into a buffer of your
+//     own, use ReadInto or ReadPooled; for lazy reading, use the Reader() method.
 //
 // # Example
 //
@@ -40,19 +44,34 @@ import (
 //
 // Note: This example assumes proper error handling and import statements.
 func (op *Operator) Read(path string) ([]byte, error) {
-	read := getFFI[operatorRead](op.ctx, symOperatorRead)
-	bytes, err := read(op.inner, path)
-	if err != nil {
-		return nil, err
-	}
+	return op.ReadContext(context.Background(), path)
+}
 
-	data := parseBytes(bytes)
-	if len(data) > 0 {
-		free := getFFI[bytesFree](op.ctx, symBytesFree)
-		free(bytes)
+// ReadContext is like Read but honors the deadline and cancellation of ctx.
+//
+// If ctx is done before the read starts, ReadContext returns an error wrapping
+// ctx.Err(). A read that has started cannot be interrupted and runs to completion.
+func (op *Operator) ReadContext(ctx context.Context, path string) ([]byte, error) {
+	return interceptValue(op, ctx, &Call{Operation: "read", Path: path}, func(ctx context.Context) ([]byte, error) {
+		read := op.syms.operatorRead
+		if read == nil {
+			return nil, errUnsupported(symOperatorRead)
+		}
+		return callContext(ctx, "read", func() ([]byte, error) {
+			bytes, err := read(op.inner, path)
+			if err != nil {
+				return nil, err
+			}
 
-	}
-	return data, nil
+			data := parseBytes(bytes)
+			if len(data) > 0 {
+				free := op.syms.bytesFree
+				free(bytes)
+
+			}
+			return data, nil
+		})
+	})
 }
 
 // Reader creates a new Reader for reading the contents of a file at the specified path.
@@ -65,12 +84,13 @@ func (op *Operator) Read(path string) ([]byte, error) {
 //
 // # Returns
 //
-//   - *OperatorReader: A reader for accessing the file's contents. It implements `io.ReadCloser`.
+//   - *OperatorReader: A reader for accessing the file's contents. It implements `io.ReadCloser`,
+//     `io.Seeker` and `io.ReaderAt`.
 //   - error: An error if the reader creation fails, or nil if successful.
 //
 // # Notes
 //
-//   - This implementation does not support the `reader_with` functionality.
+//   - To read a range, use ReaderWith instead.
 //   - The returned reader allows for more controlled and efficient reading of large files.
 //
 // # Example
@@ -87,6 +107,9 @@ func (op *Operator) Read(path string) ([]byte, error) {
 //
 //		for {
 //			n, err := r.Read(buffer)
+//			if err == io.EOF {
+//				break
+//			}
 //			if err != nil {
 //				log.Fatal(err)
 //			}
@@ -96,24 +119,239 @@ func (op *Operator) Read(path string) ([]byte, error) {
 //
 // Note: This example assumes proper error handling and import statements.
 func (op *Operator) Reader(path string) (*OperatorReader, error) {
-	getReader := getFFI[operatorReader](op.ctx, symOperatorReader)
-	inner, err := getReader(op.inner, path)
-	if err != nil {
-		return nil, err
+	return op.ReaderContext(context.Background(), path)
+}
+
+// ReaderContext is like Reader but honors the deadline and cancellation of ctx.
+//
+// The returned reader keeps ctx: once ctx is done, its Read and ReadAt methods
+// return an error wrapping ctx.Err().
+func (op *Operator) ReaderContext(ctx context.Context, path string) (*OperatorReader, error) {
+	r, err := interceptValue(op, ctx, &Call{Operation: "reader", Path: path}, func(ctx context.Context) (*OperatorReader, error) {
+		return op.readerWith(ctx, path, newReadOptions(nil))
+	})
+	if err == nil {
+		r.intercepted = op.intercepting(ctx)
+	}
+	return r, err
+}
+
+// ReadWith reads the contents of the file at the specified path with the given options.
+//
+// The C binding has no options-based read, so the range is read through a reader
+// (`opendal_operator_reader`) and applied on the Go side. Conditions are checked
+// against the metadata of the file before it is opened.
+//
+// # Parameters
+//
+//   - path: The path of the file to read.
+//   - opts: Options such as ReadOffset, ReadLength, ReadIfMatch, ReadIfNoneMatch,
+//     ReadIfModifiedSince and ReadIfUnmodifiedSince.
+//
+// # Returns
+//
+//   - []byte: The requested contents of the file.
+//   - error: An error if the read operation fails, or nil if successful.
+//
+// # Notes
+//
+//   - If the requested range starts beyond the end of the file, an error with code
+//     opendal.CodeRangeNotSatisfied is returned.
+//   - Without `opendal_reader_seek` in the loaded C binding, the bytes before the
+//     offset are read and discarded.
+//   - If a condition is not met, an error with code opendal.CodeConditionNotMatch is
+//     returned. ETag conditions need the service to return an ETag, and time conditions
+//     a last modified time; an error with code opendal.CodeUnsupported is returned otherwise.
+//   - Conditions are checked by a Stat before the read, so a change to the file between
+//     the two is not detected.
+//   - The response overrides of OpenDAL (cache control, content type and content
+//     disposition) only change the headers of the response of the service, which the
+//     C reader doesn't expose, so they are not offered.
+//
+// # Example
+//
+//	func exampleReadWith(op *opendal.Operator) {
+//		data, err := op.ReadWith("path/to/file",
+//			opendal.ReadOffset(1024),
+//			opendal.ReadLength(512),
+//		)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("Read: %s\n", data)
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) ReadWith(path string, opts ...ReadOption) ([]byte, error) {
+	return op.ReadWithContext(context.Background(), path, opts...)
+}
+
+// ReadWithContext is like ReadWith but honors the deadline and cancellation of ctx.
+func (op *Operator) ReadWithContext(ctx context.Context, path string, opts ...ReadOption) ([]byte, error) {
+	return interceptValue(op, ctx, &Call{Operation: "read_with", Path: path, Args: []any{opts}}, func(ctx context.Context) ([]byte, error) {
+		r, err := op.readerWith(ctx, path, newReadOptions(opts))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return r.readAll()
+	})
+}
+
+// ReaderWith creates a new Reader for the file at the specified path with the given options.
+//
+// It accepts the same options as ReadWith. With range options, the returned reader
+// only sees the selected range: offset 0 is the start of the range and Size
+// returns the length of the range.
+func (op *Operator) ReaderWith(path string, opts ...ReadOption) (*OperatorReader, error) {
+	return op.ReaderWithContext(context.Background(), path, opts...)
+}
+
+// ReaderWithContext is like ReaderWith but honors the deadline and cancellation of ctx.
+func (op *Operator) ReaderWithContext(ctx context.Context, path string, opts ...ReadOption) (*OperatorReader, error) {
+	r, err := interceptValue(op, ctx, &Call{Operation: "reader_with", Path: path, Args: []any{opts}}, func(ctx context.Context) (*OperatorReader, error) {
+		return op.readerWith(ctx, path, newReadOptions(opts))
+	})
+	if err == nil {
+		r.intercepted = op.intercepting(ctx)
+	}
+	return r, err
+}
+
+func (op *Operator) readerWith(ctx context.Context, path string, o *readOptions) (*OperatorReader, error) {
+	getReader := op.syms.operatorReader
+	if getReader == nil {
+		return nil, errUnsupported(symOperatorReader)
 	}
-	reader := &OperatorReader{
-		inner: inner,
-		op:    op,
+	if o.offset != 0 || !o.conditions.empty() {
+		meta, err := op.StatContext(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		if err := o.conditions.check(op, path, meta); err != nil {
+			return nil, err
+		}
+		if o.offset != 0 && uint64(o.offset) >= meta.ContentLength() {
+			return nil, &Error{
+				code:    CodeRangeNotSatisfied,
+				message: fmt.Sprintf("offset %d is beyond the content length %d", o.offset, meta.ContentLength()),
+			}
+		}
+	}
+	return callContext(ctx, "reader", func() (*OperatorReader, error) {
+		inner, err := getReader(op.inner, path)
+		if err != nil {
+			return nil, err
+		}
+		reader := &OperatorReader{
+			inner:   inner,
+			op:      op,
+			callCtx: ctx,
+			path:    path,
+			opts:    o,
+			start:   o.offset,
+			length:  o.length,
+			size:    -1,
+		}
+		return reader, nil
+	})
+}
+
+// ReadOption configures ReadWith and ReaderWith.
+type ReadOption func(o *readOptions)
+
+// ReadOffset sets the offset in bytes where the read starts.
+func ReadOffset(offset uint64) ReadOption {
+	return func(o *readOptions) {
+		o.offset = int64(offset)
+	}
+}
+
+// ReadLength limits the read to at most length bytes.
+func ReadLength(length uint64) ReadOption {
+	return func(o *readOptions) {
+		o.length = int64(length)
+	}
+}
+
+// ReadIfMatch only reads the file if its ETag matches etag. As with StatIfMatch,
+// ETags are compared strongly.
+func ReadIfMatch(etag string) ReadOption {
+	return func(o *readOptions) {
+		o.ifMatch = etag
+	}
+}
+
+// ReadIfNoneMatch only reads the file if its ETag does not match etag. As with
+// StatIfNoneMatch, ETags are compared weakly.
+func ReadIfNoneMatch(etag string) ReadOption {
+	return func(o *readOptions) {
+		o.ifNoneMatch = etag
+	}
+}
+
+// ReadIfModifiedSince only reads the file if it was modified after t.
+func ReadIfModifiedSince(t time.Time) ReadOption {
+	return func(o *readOptions) {
+		o.ifModifiedSince = t
+	}
+}
+
+// ReadIfUnmodifiedSince only reads the file if it was not modified after t.
+func ReadIfUnmodifiedSince(t time.Time) ReadOption {
+	return func(o *readOptions) {
+		o.ifUnmodifiedSince = t
+	}
+}
+
+type readOptions struct {
+	conditions
+
+	offset int64
+	// length is -1 if the read is not limited.
+	length int64
+}
+
+func newReadOptions(opts []ReadOption) *readOptions {
+	o := &readOptions{length: -1}
+	for _, opt := range opts {
+		opt(o)
 	}
-	return reader, nil
+	return o
 }
 
 type OperatorReader struct {
-	inner *opendalReader
-	op    *Operator // // hold the op pointer to ensure it is gc after OperatorReader instance.
+	inner   *opendalReader
+	op      *Operator // // hold the op pointer to ensure it is gc after OperatorReader instance.
+	callCtx context.Context
+	path    string
+	opts    *readOptions
+
+	// start and length describe the range selected by ReadOffset and ReadLength.
+	// length is -1 if the range is unbounded. The C reader covers the whole file and
+	// the range is applied on the Go side.
+	start  int64
+	length int64
+
+	// pos is the position of the underlying C reader, while offset is the position
+	// requested by the caller. They differ after a Seek until the next Read.
+	// pos is -1 if the underlying reader failed and has to be reopened.
+	pos    int64
+	offset int64
+	// size is the content length of the file, or -1 if it hasn't been fetched yet.
+	size int64
+
+	// intercepted is set if Read and ReadAt go through the interceptors of op.
+	intercepted bool
+	closed      bool
 }
 
-var _ io.ReadCloser = (*OperatorReader)(nil)
+var (
+	_ io.ReadCloser = (*OperatorReader)(nil)
+	_ io.Seeker     = (*OperatorReader)(nil)
+	_ io.ReaderAt   = (*OperatorReader)(nil)
+	_ io.WriterTo   = (*OperatorReader)(nil)
+)
 
 // Read reads data from the underlying storage into the provided buffer.
 //
@@ -126,15 +364,16 @@ var _ io.ReadCloser = (*OperatorReader)(nil)
 //
 // # Returns
 //
-//   - int: The number of bytes read. Returns 0 if no data is available or the end of the file is reached.
-//   - error: An error if the read operation fails, or nil if successful.
-//     Note that this method does not return io.EOF; it returns nil at the end of the file.
+//   - int: The number of bytes read.
+//   - error: io.EOF at the end of the file or range, an error if the read operation
+//     fails, or nil if successful.
 //
 // # Notes
 //
-//   - This method only returns OpenDAL-specific errors, not io.EOF.
-//   - If no data is read (end of file), it returns (0, nil) instead of (0, io.EOF).
-//   - The caller is responsible for pre-allocating the buffer and determining its size.
+//   - Read returns as soon as some data is available, so n may be less than len(buf)
+//     before the end of the file. Use io.ReadFull to fill buf.
+//   - Read makes a single call into the C binding. Prefer io.Copy, which uses WriteTo,
+//     to stream a whole file with fewer calls.
 //
 // # Example
 //
@@ -147,50 +386,320 @@ var _ io.ReadCloser = (*OperatorReader)(nil)
 //	buf := make([]byte, 1024)
 //	for {
 //		n, err := reader.Read(buf)
+//		// Process buf[:n]
+//		if err == io.EOF {
+//			break
+//		}
 //		if err != nil {
 //			log.Fatal(err)
 //		}
-//		if n == 0 {
-//			break // End of file
-//		}
-//		// Process buf[:n]
 //	}
 //
-// Note: Always check the number of bytes read (n) as it may be less than len(buf).
-func (r *OperatorReader) Read(buf []byte) (int, error) {
-	length := uint(len(buf))
-	read := getFFI[readerRead](r.op.ctx, symReaderRead)
-	var (
-		totalSize uint
-		size      uint
-		err       error
-	)
+// Note: This example assumes proper error handling and import statements.
+func (r *OperatorReader) Read(buf []byte) (n int, err error) {
+	if !r.intercepted {
+		n, err = r.read(buf)
+		return n, annotate(err, &Call{Operation: "reader.read", Path: r.path})
+	}
+	err = r.op.invoke(r.callCtx, &Call{Operation: "reader.read", Path: r.path, Args: []any{buf}}, func(context.Context) (err error) {
+		n, err = r.read(buf)
+		return
+	})
+	return
+}
+
+func (r *OperatorReader) read(buf []byte) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if err := r.callCtx.Err(); err != nil {
+		return 0, contextError("read", err)
+	}
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	if r.length >= 0 {
+		remaining := max(r.length-r.offset, 0)
+		if remaining == 0 {
+			return 0, io.EOF
+		}
+		if int64(len(buf)) > remaining {
+			buf = buf[:remaining]
+		}
+	}
+	err := r.reposition()
+	if err != nil {
+		return 0, err
+	}
+	read := r.op.syms.readerRead
+	size, err := read(r.inner, buf)
+	if err != nil {
+		// The state of the underlying reader is unknown after a failure. Reopen it
+		// on the next Read, which resumes at the same offset.
+		r.pos = -1
+		return 0, err
+	}
+	if size == 0 {
+		return 0, io.EOF
+	}
+	r.pos += int64(size)
+	r.offset += int64(size)
+	return int(size), nil
+}
+
+// writeToBufferSize is the size of the buffer used by WriteTo.
+const writeToBufferSize = 1024 * 1024
+
+// WriteTo writes the data from the current offset to the end of the file or range
+// to w, and returns the number of bytes written.
+//
+// This method implements the io.WriterTo interface for OperatorReader, which is
+// used by io.Copy. It reads with a buffer of 1 MiB to reduce the number of calls
+// into the C binding.
+func (r *OperatorReader) WriteTo(w io.Writer) (total int64, err error) {
+	buf := make([]byte, writeToBufferSize)
+	if r.length >= 0 {
+		buf = buf[:max(min(r.length-r.offset, writeToBufferSize), 1)]
+	}
 	for {
-		size, err = read(r.inner, buf[totalSize:])
-		totalSize += size
-		if size == 0 || err != nil || totalSize >= length {
-			break
+		n, err := r.Read(buf)
+		if n > 0 {
+			written, err := w.Write(buf[:n])
+			total += int64(written)
+			if err != nil {
+				return total, err
+			}
+			if written < n {
+				return total, io.ErrShortWrite
+			}
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// Seek sets the offset for the next Read to offset, interpreted according to whence.
+//
+// This method implements the io.Seeker interface for OperatorReader.
+//
+// # Notes
+//
+//   - Seeking is lazy: the underlying reader is only repositioned by the next Read.
+//   - Seeking relative to io.SeekEnd fetches the file size with Stat once.
+//   - If the loaded C binding does not export `opendal_reader_seek`, the next Read
+//     downloads and discards every byte between the current position and the new
+//     offset, and a backward seek reopens the file and starts over from its beginning.
+//     Seeking far ahead in a large file is then as slow as reading up to that point.
+//   - Seeking beyond the end of the file is allowed; subsequent reads return io.EOF.
+func (r *OperatorReader) Seek(offset int64, whence int) (int64, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		size, err := r.Size()
+		if err != nil {
+			return 0, err
 		}
+		abs = size + offset
+	default:
+		return 0, errors.New("opendal: invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("opendal: negative position")
+	}
+	r.offset = abs
+	return abs, nil
+}
+
+// ReadAt reads len(buf) bytes starting at byte offset off.
+//
+// This method implements the io.ReaderAt interface for OperatorReader. It opens
+// a separate reader for each call, so it neither depends on nor changes the
+// offset used by Read and Seek, and it is safe to call concurrently.
+//
+// When fewer than len(buf) bytes are available, ReadAt returns io.EOF.
+//
+// Each call costs a new reader. If the loaded C binding does not export
+// `opendal_reader_seek`, that reader also downloads and discards the first off
+// bytes of the file, so ReadAt near the end of a large file is as slow as reading
+// the whole file. Prefer a single sequential Read or WriteTo in that case.
+func (r *OperatorReader) ReadAt(buf []byte, off int64) (n int, err error) {
+	if !r.intercepted {
+		n, err = r.readAt(buf, off)
+		return n, annotate(err, &Call{Operation: "reader.read_at", Path: r.path})
+	}
+	err = r.op.invoke(r.callCtx, &Call{Operation: "reader.read_at", Path: r.path, Args: []any{buf, off}}, func(context.Context) (err error) {
+		n, err = r.readAt(buf, off)
+		return
+	})
+	return
+}
+
+func (r *OperatorReader) readAt(buf []byte, off int64) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if off < 0 {
+		return 0, errors.New("opendal: negative offset")
 	}
-	return int(totalSize), err
+	reader, err := r.op.readerWith(r.callCtx, r.path, r.opts)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	reader.offset = off
+	n, err := reader.readFull(buf)
+	if err == nil && n < len(buf) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Size returns the content length of the file, or of the selected range if the
+// reader was created with range options.
+//
+// The size is fetched with Stat on the first call and cached afterwards.
+func (r *OperatorReader) Size() (int64, error) {
+	if r.size >= 0 {
+		return r.size, nil
+	}
+	meta, err := r.op.StatContext(r.callCtx, r.path)
+	if err != nil {
+		return 0, err
+	}
+	size := max(int64(meta.ContentLength())-r.start, 0)
+	if r.length >= 0 {
+		size = min(size, r.length)
+	}
+	r.size = size
+	return r.size, nil
 }
 
 // Close releases resources associated with the OperatorReader.
+//
+// Read, ReadAt, Seek and WriteTo return os.ErrClosed once the reader is closed,
+// and so does a second Close.
 func (r *OperatorReader) Close() error {
-	free := getFFI[readerFree](r.op.ctx, symReaderFree)
+	if r.closed {
+		return os.ErrClosed
+	}
+	r.closed = true
+	free := r.op.syms.readerFree
 	free(r.inner)
 	return nil
 }
 
+// target returns the position of the underlying C reader for the current offset.
+func (r *OperatorReader) target() int64 {
+	return r.start + r.offset
+}
+
+// reposition moves the underlying reader to the offset requested by Seek.
+func (r *OperatorReader) reposition() error {
+	target := r.target()
+	if r.pos == target {
+		return nil
+	}
+	if r.pos < 0 {
+		if err := r.reopen(); err != nil {
+			return err
+		}
+		if r.pos == target {
+			return nil
+		}
+	}
+	if seek := r.op.syms.readerSeek; seek != nil {
+		pos, err := seek(r.inner, target, io.SeekStart)
+		if err != nil {
+			return err
+		}
+		r.pos = int64(pos)
+		return nil
+	}
+	if target < r.pos {
+		if err := r.reopen(); err != nil {
+			return err
+		}
+	}
+	return r.discard(target - r.pos)
+}
+
+// reopen replaces the underlying reader with a new one positioned at the start.
+func (r *OperatorReader) reopen() error {
+	getReader := r.op.syms.operatorReader
+	inner, err := getReader(r.op.inner, r.path)
+	if err != nil {
+		return err
+	}
+	free := r.op.syms.readerFree
+	free(r.inner)
+	r.inner = inner
+	r.pos = 0
+	return nil
+}
+
+// discard skips n bytes of the underlying reader.
+func (r *OperatorReader) discard(n int64) error {
+	read := r.op.syms.readerRead
+	buf := make([]byte, min(n, 32*1024))
+	for n > 0 {
+		if err := r.callCtx.Err(); err != nil {
+			return contextError("seek", err)
+		}
+		size, err := read(r.inner, buf[:min(n, int64(len(buf)))])
+		if err != nil {
+			r.pos = -1
+			return err
+		}
+		if size == 0 {
+			// Reached the end of the file, any further read returns no data.
+			r.pos = r.target()
+			return nil
+		}
+		r.pos += int64(size)
+		n -= int64(size)
+	}
+	return nil
+}
+
+// readAll reads from the current offset until the end of the file or range.
+func (r *OperatorReader) readAll() ([]byte, error) {
+	data := make([]byte, 0, 512)
+	for {
+		if len(data) == cap(data) {
+			data = append(data, 0)[:len(data)]
+		}
+		n, err := r.Read(data[len(data):cap(data)])
+		data = data[:len(data)+n]
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
 const symOperatorRead = "opendal_operator_read"
 
 type operatorRead func(op *opendalOperator, path string) (*opendalBytes, error)
 
 var withOperatorRead = withFFI(ffiOpts{
-	sym:    symOperatorRead,
-	rType:  &typeResultRead,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorRead {
+	sym:      symOperatorRead,
+	rType:    &typeResultRead,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorRead {
 	return func(op *opendalOperator, path string) (*opendalBytes, error) {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -202,7 +711,7 @@ var withOperatorRead = withFFI(ffiOpts{
 			unsafe.Pointer(&op),
 			unsafe.Pointer(&bytePath),
 		)
-		return result.data, parseError(ctx, result.error)
+		return result.data, parseError(syms, result.error)
 	}
 })
 
@@ -211,10 +720,11 @@ const symOperatorReader = "opendal_operator_reader"
 type operatorReader func(op *opendalOperator, path string) (*opendalReader, error)
 
 var withOperatorReader = withFFI(ffiOpts{
-	sym:    symOperatorReader,
-	rType:  &typeResultOperatorReader,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorReader {
+	sym:      symOperatorReader,
+	rType:    &typeResultOperatorReader,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorReader {
 	return func(op *opendalOperator, path string) (*opendalReader, error) {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -227,7 +737,7 @@ var withOperatorReader = withFFI(ffiOpts{
 			unsafe.Pointer(&bytePath),
 		)
 		if result.error != nil {
-			return nil, parseError(ctx, result.error)
+			return nil, parseError(syms, result.error)
 		}
 		return result.reader, nil
 	}
@@ -241,7 +751,7 @@ var withReaderFree = withFFI(ffiOpts{
 	sym:    symReaderFree,
 	rType:  &ffi.TypeVoid,
 	aTypes: []*ffi.Type{&ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) readerFree {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) readerFree {
 	return func(r *opendalReader) {
 		ffiCall(
 			nil,
@@ -258,7 +768,7 @@ var withReaderRead = withFFI(ffiOpts{
 	sym:    symReaderRead,
 	rType:  &typeResultReaderRead,
 	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) readerRead {
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) readerRead {
 	return func(r *opendalReader, buf []byte) (size uint, err error) {
 		var length = len(buf)
 		if length == 0 {
@@ -273,8 +783,34 @@ var withReaderRead = withFFI(ffiOpts{
 			unsafe.Pointer(&length),
 		)
 		if result.error != nil {
-			return 0, parseError(ctx, result.error)
+			return 0, parseError(syms, result.error)
 		}
 		return result.size, nil
 	}
 })
+
+const symReaderSeek = "opendal_reader_seek"
+
+type readerSeek func(r *opendalReader, offset int64, whence int) (pos uint64, err error)
+
+var withReaderSeek = withFFI(ffiOpts{
+	sym:      symReaderSeek,
+	rType:    &typeResultReaderSeek,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypeSint64, &ffi.TypeSint32},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) readerSeek {
+	return func(r *opendalReader, offset int64, whence int) (pos uint64, err error) {
+		w := int32(whence)
+		var result resultReaderSeek
+		ffiCall(
+			unsafe.Pointer(&result),
+			unsafe.Pointer(&r),
+			unsafe.Pointer(&offset),
+			unsafe.Pointer(&w),
+		)
+		if result.error != nil {
+			return 0, parseError(syms, result.error)
+		}
+		return result.pos, nil
+	}
+})
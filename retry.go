@@ -0,0 +1,167 @@
+package opendal
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// Retry returns an Interceptor that retries operations failing with a temporary error.
+//
+// Use it with Operator.Layer. An error is temporary if it implements a Temporary method
+// returning true, such as *Error for rate limiting.
+//
+// # Parameters
+//
+//   - opts: Options such as RetryMaxAttempts, RetryMinDelay, RetryMaxDelay, RetryFactor
+//     or RetryJitter. By default, an operation is attempted up to 4 times, waiting 1s,
+//     2s and 4s between the attempts, and the delay is capped at 60s.
+//
+// # Notes
+//
+//   - A failed OperatorReader.Read is retried from the current offset of the reader:
+//     the underlying reader is reopened and skips to where the failed Read started.
+//   - Operations that are not idempotent are never retried: Writer.Write, Writer.Close,
+//     Append and writes with WriteAppend, since the data of a failed attempt may already
+//     have been written, and Rename and Copy, since a failed attempt may already have
+//     moved or replaced files.
+//   - DeleteMany and RemoveAll are retried as a whole, as deleting the paths again is
+//     harmless. The operations they are made of are not seen by the interceptors.
+//   - If ctx is done while waiting for the next attempt, an error wrapping ctx.Err()
+//     is returned.
+//
+// # Example
+//
+//	func exampleRetry(op *opendal.Operator) {
+//		op = op.Layer(opendal.Retry(
+//			opendal.RetryMaxAttempts(5),
+//			opendal.RetryMinDelay(100*time.Millisecond),
+//			opendal.RetryJitter(true),
+//		))
+//		data, err := op.Read("path/to/file")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("Read: %s\n", data)
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func Retry(opts ...RetryOption) Interceptor {
+	o := &retryOptions{
+		maxAttempts: 4,
+		minDelay:    time.Second,
+		maxDelay:    time.Minute,
+		factor:      2,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(ctx context.Context, call *Call, invoke Invoker) error {
+		if !retryable(call) {
+			return invoke(ctx)
+		}
+		delay := o.minDelay
+		for attempt := 1; ; attempt++ {
+			err := invoke(ctx)
+			if err == nil || attempt >= o.maxAttempts || !isTemporary(err) {
+				return err
+			}
+			wait := delay
+			if o.jitter && wait > 0 {
+				wait = rand.N(wait + 1)
+			}
+			if o.notify != nil {
+				o.notify(call, err, wait)
+			}
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return contextError(call.Operation, ctx.Err())
+			case <-timer.C:
+			}
+			delay = min(time.Duration(float64(delay)*o.factor), o.maxDelay)
+		}
+	}
+}
+
+// RetryOption configures Retry.
+type RetryOption func(o *retryOptions)
+
+// RetryMaxAttempts sets how many times an operation is attempted in total,
+// including the first attempt. Values below 1 are treated as 1.
+func RetryMaxAttempts(n int) RetryOption {
+	return func(o *retryOptions) {
+		o.maxAttempts = max(n, 1)
+	}
+}
+
+// RetryMinDelay sets the delay before the first retry.
+func RetryMinDelay(d time.Duration) RetryOption {
+	return func(o *retryOptions) {
+		o.minDelay = d
+	}
+}
+
+// RetryMaxDelay caps the delay between two attempts.
+func RetryMaxDelay(d time.Duration) RetryOption {
+	return func(o *retryOptions) {
+		o.maxDelay = d
+	}
+}
+
+// RetryFactor sets how much the delay grows after each retry.
+func RetryFactor(factor float64) RetryOption {
+	return func(o *retryOptions) {
+		o.factor = factor
+	}
+}
+
+// RetryJitter randomizes each delay between zero and its computed value, which
+// spreads out the retries of concurrent clients.
+func RetryJitter(enabled bool) RetryOption {
+	return func(o *retryOptions) {
+		o.jitter = enabled
+	}
+}
+
+// RetryNotify sets a function called before waiting for each retry, with the
+// error of the failed attempt and the delay until the next one.
+func RetryNotify(notify func(call *Call, err error, delay time.Duration)) RetryOption {
+	return func(o *retryOptions) {
+		o.notify = notify
+	}
+}
+
+type retryOptions struct {
+	maxAttempts int
+	minDelay    time.Duration
+	maxDelay    time.Duration
+	factor      float64
+	jitter      bool
+	notify      func(call *Call, err error, delay time.Duration)
+}
+
+// retryable reports whether an operation can be attempted again after it failed.
+func retryable(call *Call) bool {
+	switch call.Operation {
+	case "writer.write", "writer.close", "append", "rename", "copy":
+		// A failed attempt may have taken effect partially or completely.
+		return false
+	case "write_with", "writer_with":
+		// Appending twice would duplicate the data.
+		for _, arg := range call.Args {
+			if opts, ok := arg.([]WriteOption); ok {
+				return !newWriteOptions(opts).append
+			}
+		}
+	}
+	return true
+}
+
+// isTemporary reports whether err or any error it wraps is temporary.
+func isTemporary(err error) bool {
+	var temporary interface{ Temporary() bool }
+	return errors.As(err, &temporary) && temporary.Temporary()
+}
@@ -0,0 +1,45 @@
+package opendal
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryReaderResume(t *testing.T) {
+	assert := require.New(t)
+
+	content := bytes.Repeat([]byte("0123456789abcdef"), 64)
+	f := &fakeFile{content: content, failAt: 300}
+
+	var retries int
+	op := f.operator().Layer(Retry(
+		RetryMinDelay(time.Millisecond),
+		RetryMaxDelay(time.Millisecond),
+		RetryNotify(func(call *Call, err error, delay time.Duration) {
+			assert.Equal("reader.read", call.Operation)
+			retries++
+		}),
+	))
+
+	r, err := op.Reader("file")
+	assert.Nil(err)
+	defer r.Close()
+
+	data := make([]byte, 0, len(content))
+	buf := make([]byte, 100)
+	for {
+		n, err := r.Read(buf)
+		data = append(data, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(err, "read must succeed after a retry")
+	}
+	assert.Equal(1, retries)
+	assert.Equal(2, f.readers, "the failed reader must be reopened")
+	assert.Equal(content, data)
+}
@@ -0,0 +1,154 @@
+package opendal_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsRetry(cap *opendal.Capability) []behaviorTest {
+	if !cap.Read() || !cap.Write() || !cap.Stat() {
+		return nil
+	}
+	return []behaviorTest{
+		testRetryTemporaryError,
+		testRetryPersistentError,
+		testRetryMaxAttempts,
+		testRetryDeleteMany,
+		testRetryNotIdempotent,
+	}
+}
+
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "temporary failure" }
+func (temporaryError) Temporary() bool { return true }
+
+// failing returns an interceptor that fails the given operation without performing
+// it whenever shouldFail returns true, and counts the attempts.
+func failing(operation string, attempts *int, shouldFail func(attempt int) bool) opendal.Interceptor {
+	return func(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+		if call.Operation != operation {
+			return invoke(ctx)
+		}
+		*attempts++
+		if shouldFail(*attempts) {
+			return temporaryError{}
+		}
+		return invoke(ctx)
+	}
+}
+
+func fastRetry(opts ...opendal.RetryOption) opendal.Interceptor {
+	return opendal.Retry(append([]opendal.RetryOption{
+		opendal.RetryMinDelay(time.Millisecond),
+		opendal.RetryMaxDelay(5 * time.Millisecond),
+	}, opts...)...)
+}
+
+func testRetryTemporaryError(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	var attempts, notified int
+	layered := op.
+		Layer(failing("stat", &attempts, func(attempt int) bool { return attempt <= 2 })).
+		Layer(fastRetry(opendal.RetryNotify(func(call *opendal.Call, err error, delay time.Duration) {
+			notified++
+		})))
+
+	meta, err := layered.Stat(path)
+	assert.Nil(err, "stat must succeed after retries")
+	assert.Equal(uint64(size), meta.ContentLength())
+	assert.Equal(3, attempts)
+	assert.Equal(2, notified)
+}
+
+func testRetryPersistentError(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	var attempts int
+	layered := op.
+		Layer(failing("stat", &attempts, func(int) bool { return false })).
+		Layer(fastRetry())
+
+	_, err := layered.Stat(fixture.NewFilePath())
+	assert.NotNil(err)
+	assert.Equal(opendal.CodeNotFound, assertErrorCode(err))
+	assert.False(err.(*opendal.Error).Temporary())
+	assert.Equal(1, attempts, "persistent errors must not be retried")
+}
+
+func testRetryMaxAttempts(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	var attempts int
+	layered := op.
+		Layer(failing("read", &attempts, func(int) bool { return true })).
+		Layer(fastRetry(opendal.RetryMaxAttempts(3), opendal.RetryJitter(true)))
+
+	_, err := layered.Read(path)
+	assert.ErrorIs(err, temporaryError{})
+	assert.Equal(3, attempts)
+}
+
+func testRetryDeleteMany(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	if !op.Info().GetFullCapability().Delete() {
+		return
+	}
+	paths := []string{fixture.NewFilePath(), fixture.NewFilePath()}
+	for _, path := range paths {
+		assert.Nil(op.Write(path, genFixedBytes(16)), "write must succeed")
+	}
+
+	// The first attempt deletes the paths and then fails, the retry must find
+	// nothing left to delete and succeed.
+	var attempts int
+	layered := op.
+		Layer(func(ctx context.Context, call *opendal.Call, invoke opendal.Invoker) error {
+			err := invoke(ctx)
+			if call.Operation == "delete_many" {
+				attempts++
+				if attempts == 1 && err == nil {
+					return temporaryError{}
+				}
+			}
+			return err
+		}).
+		Layer(fastRetry())
+
+	assert.Nil(layered.DeleteMany(paths), "delete_many must succeed after a retry")
+	assert.Equal(2, attempts)
+	for _, path := range paths {
+		exist, err := op.IsExist(path)
+		assert.Nil(err)
+		assert.False(exist, "%s must be deleted", path)
+	}
+}
+
+func testRetryNotIdempotent(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	for operation, call := range map[string]func(op *opendal.Operator) error{
+		"append": func(op *opendal.Operator) error {
+			return op.Append(fixture.NewFilePath(), []byte("data"))
+		},
+		"write_with": func(op *opendal.Operator) error {
+			_, err := op.WriteWith(fixture.NewFilePath(), []byte("data"), opendal.WriteAppend())
+			return err
+		},
+		"rename": func(op *opendal.Operator) error {
+			return op.Rename(fixture.NewFilePath(), fixture.NewFilePath())
+		},
+		"copy": func(op *opendal.Operator) error {
+			return op.Copy(fixture.NewFilePath(), fixture.NewFilePath())
+		},
+	} {
+		var attempts int
+		layered := op.
+			Layer(failing(operation, &attempts, func(int) bool { return true })).
+			Layer(fastRetry())
+
+		assert.ErrorIs(call(layered), temporaryError{}, operation)
+		assert.Equal(1, attempts, "%s must not be retried", operation)
+	}
+}
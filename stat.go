@@ -2,6 +2,9 @@ package opendal
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
@@ -23,7 +26,7 @@ import (
 //
 // # Notes
 //
-//   - The current implementation does not support `stat_with` functionality.
+//   - Use StatWith to make the request conditional or to select a version.
 //   - If the path does not exist, an error with code opendal.CodeNotFound will be returned.
 //
 // # Example
@@ -43,12 +46,234 @@ import (
 //
 // Note: This example assumes proper error handling and import statements.
 func (op *Operator) Stat(path string) (*Metadata, error) {
-	stat := getFFI[operatorStat](op.ctx, symOperatorStat)
-	meta, err := stat(op.inner, path)
-	if err != nil {
-		return nil, err
+	return op.StatContext(context.Background(), path)
+}
+
+// StatContext is like Stat but honors the deadline and cancellation of ctx.
+func (op *Operator) StatContext(ctx context.Context, path string) (*Metadata, error) {
+	return interceptValue(op, ctx, &Call{Operation: "stat", Path: path}, func(ctx context.Context) (*Metadata, error) {
+		stat := op.syms.operatorStat
+		if stat == nil {
+			return nil, errUnsupported(symOperatorStat)
+		}
+		return callContext(ctx, "stat", func() (*Metadata, error) {
+			meta, err := stat(op.inner, path)
+			if err != nil {
+				return nil, err
+			}
+			return newMetadata(op.syms, meta), nil
+		})
+	})
+}
+
+// StatWith retrieves metadata for the specified path with the given options.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_stat_with`.
+// If the loaded C binding does not export it, StatWith is a Stat followed by a
+// comparison of the returned ETag on the Go side, which costs the same single request.
+//
+// # Parameters
+//
+//   - path: The path of the file or directory to get metadata for.
+//   - opts: Options such as StatIfMatch, StatIfNoneMatch or StatVersion.
+//
+// # Returns
+//
+//   - *Metadata: Metadata of the specified path.
+//   - error: An error if the operation fails, or nil if successful.
+//
+// # Notes
+//
+//   - If the ETag condition is not met, an error with code opendal.CodeConditionNotMatch is returned.
+//   - Without `opendal_operator_stat_with`, the ETag conditions need the service to return an
+//     ETag for the path, and StatVersion can't be used. An error with code
+//     opendal.CodeUnsupported is returned otherwise.
+//
+// # Example
+//
+//	func exampleStatWith(op *opendal.Operator, etag string) {
+//		meta, err := op.StatWith("/path/to/file", opendal.StatIfNoneMatch(etag))
+//		if err != nil {
+//			if errors.Is(err, opendal.ErrConditionNotMatch) {
+//				fmt.Println("File not modified")
+//				return
+//			}
+//			log.Fatalf("Stat operation failed: %v", err)
+//		}
+//		etag, _ = meta.ETag()
+//		fmt.Printf("File changed, new ETag: %s\n", etag)
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) StatWith(path string, opts ...StatOption) (*Metadata, error) {
+	return op.StatWithContext(context.Background(), path, opts...)
+}
+
+// StatWithContext is like StatWith but honors the deadline and cancellation of ctx.
+func (op *Operator) StatWithContext(ctx context.Context, path string, opts ...StatOption) (*Metadata, error) {
+	return interceptValue(op, ctx, &Call{Operation: "stat_with", Path: path, Args: []any{opts}}, func(ctx context.Context) (*Metadata, error) {
+		o := &statOptions{}
+		for _, opt := range opts {
+			opt(o)
+		}
+		if o.empty() {
+			return op.StatContext(ctx, path)
+		}
+		if statWith := op.syms.operatorStatWith; statWith != nil {
+			return callContext(ctx, "stat", func() (*Metadata, error) {
+				meta, err := statWith(op.inner, path, o.operatorOptions())
+				if err != nil {
+					return nil, err
+				}
+				return newMetadata(op.syms, meta), nil
+			})
+		}
+		if o.version != "" {
+			return nil, errUnsupported(symOperatorStatWith)
+		}
+		meta, err := op.StatContext(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		if err := o.conditions.check(op, path, meta); err != nil {
+			return nil, err
+		}
+		return meta, nil
+	})
+}
+
+// StatOption configures StatWith.
+type StatOption func(o *statOptions)
+
+// StatIfMatch only succeeds if the ETag of the path matches etag.
+//
+// As required for If-Match by RFC 9110, ETags are compared strongly: a weak ETag
+// never matches.
+func StatIfMatch(etag string) StatOption {
+	return func(o *statOptions) {
+		o.ifMatch = etag
+	}
+}
+
+// StatIfNoneMatch only succeeds if the ETag of the path does not match etag.
+//
+// ETags are compared weakly, so W/"x" and "x" match.
+func StatIfNoneMatch(etag string) StatOption {
+	return func(o *statOptions) {
+		o.ifNoneMatch = etag
 	}
-	return newMetadata(op.ctx, meta), nil
+}
+
+// StatVersion retrieves the metadata of the given version of the path.
+//
+// Requires `opendal_operator_stat_with` in the loaded C binding and a service that
+// supports versioning.
+func StatVersion(version string) StatOption {
+	return func(o *statOptions) {
+		o.version = version
+	}
+}
+
+type statOptions struct {
+	conditions
+	version string
+}
+
+func (o *statOptions) empty() bool {
+	return o.conditions.empty() && o.version == ""
+}
+
+func (o *statOptions) operatorOptions() OperatorOptions {
+	opts := OperatorOptions{}
+	setOption(opts, "if_match", o.ifMatch)
+	setOption(opts, "if_none_match", o.ifNoneMatch)
+	setOption(opts, "version", o.version)
+	return opts
+}
+
+// conditions are the preconditions of StatWith and ReadWith, checked on the Go side
+// against the metadata of the path.
+type conditions struct {
+	ifMatch           string
+	ifNoneMatch       string
+	ifModifiedSince   time.Time
+	ifUnmodifiedSince time.Time
+}
+
+func (c *conditions) empty() bool {
+	return c.ifMatch == "" && c.ifNoneMatch == "" && c.ifModifiedSince.IsZero() && c.ifUnmodifiedSince.IsZero()
+}
+
+// check verifies the conditions against meta, the metadata of path.
+func (c *conditions) check(op *Operator, path string, meta *Metadata) error {
+	if c.ifMatch != "" || c.ifNoneMatch != "" {
+		etag, ok := meta.ETag()
+		if !ok {
+			return &Error{
+				code:    CodeUnsupported,
+				message: fmt.Sprintf("etag conditions need an etag, which service %s did not return for %s", op.Info().GetScheme(), path),
+			}
+		}
+		if c.ifMatch != "" && !matchETag(c.ifMatch, etag, false) {
+			return &Error{
+				code:    CodeConditionNotMatch,
+				message: fmt.Sprintf("etag %s does not match %s", etag, c.ifMatch),
+			}
+		}
+		if c.ifNoneMatch != "" && matchETag(c.ifNoneMatch, etag, true) {
+			return &Error{
+				code:    CodeConditionNotMatch,
+				message: fmt.Sprintf("etag %s matches %s", etag, c.ifNoneMatch),
+			}
+		}
+	}
+	if c.ifModifiedSince.IsZero() && c.ifUnmodifiedSince.IsZero() {
+		return nil
+	}
+	modified := meta.LastModified()
+	if modified.IsZero() {
+		return &Error{
+			code:    CodeUnsupported,
+			message: fmt.Sprintf("time conditions need a last modified time, which service %s did not return for %s", op.Info().GetScheme(), path),
+		}
+	}
+	if !c.ifModifiedSince.IsZero() && !modified.After(c.ifModifiedSince) {
+		return &Error{
+			code:    CodeConditionNotMatch,
+			message: fmt.Sprintf("last modified %s is not after %s", modified, c.ifModifiedSince),
+		}
+	}
+	if !c.ifUnmodifiedSince.IsZero() && modified.After(c.ifUnmodifiedSince) {
+		return &Error{
+			code:    CodeConditionNotMatch,
+			message: fmt.Sprintf("last modified %s is after %s", modified, c.ifUnmodifiedSince),
+		}
+	}
+	return nil
+}
+
+// matchETag reports whether etag satisfies the condition, which is either "*"
+// or a comma-separated list of ETags. With weak set, weak ETags are compared by
+// their value; otherwise a weak ETag on either side never matches.
+func matchETag(condition, etag string, weak bool) bool {
+	if strings.TrimSpace(condition) == "*" {
+		return true
+	}
+	if weak {
+		etag = strings.TrimPrefix(etag, "W/")
+	} else if strings.HasPrefix(etag, "W/") {
+		return false
+	}
+	for _, c := range strings.Split(condition, ",") {
+		c = strings.TrimSpace(c)
+		if weak {
+			c = strings.TrimPrefix(c, "W/")
+		}
+		if c == etag {
+			return true
+		}
+	}
+	return false
 }
 
 // IsExist checks if a file or directory exists at the specified path.
@@ -77,10 +302,21 @@ func (op *Operator) Stat(path string) (*Metadata, error) {
 //	} else {
 //		fmt.Println("The file does not exist")
 //	}
-//
 func (op *Operator) IsExist(path string) (bool, error) {
-	isExist := getFFI[operatorIsExist](op.ctx, symOperatorIsExist)
-	return isExist(op.inner, path)
+	return op.IsExistContext(context.Background(), path)
+}
+
+// IsExistContext is like IsExist but honors the deadline and cancellation of ctx.
+func (op *Operator) IsExistContext(ctx context.Context, path string) (bool, error) {
+	return interceptValue(op, ctx, &Call{Operation: "is_exist", Path: path}, func(ctx context.Context) (bool, error) {
+		isExist := op.syms.operatorIsExist
+		if isExist == nil {
+			return false, errUnsupported(symOperatorIsExist)
+		}
+		return callContext(ctx, "is_exist", func() (bool, error) {
+			return isExist(op.inner, path)
+		})
+	})
 }
 
 const symOperatorStat = "opendal_operator_stat"
@@ -88,10 +324,11 @@ const symOperatorStat = "opendal_operator_stat"
 type operatorStat func(op *opendalOperator, path string) (*opendalMetadata, error)
 
 var withOperatorStat = withFFI(ffiOpts{
-	sym:    symOperatorStat,
-	rType:  &typeResultStat,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorStat {
+	sym:      symOperatorStat,
+	rType:    &typeResultStat,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorStat {
 	return func(op *opendalOperator, path string) (*opendalMetadata, error) {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -104,7 +341,41 @@ var withOperatorStat = withFFI(ffiOpts{
 			unsafe.Pointer(&bytePath),
 		)
 		if result.error != nil {
-			return nil, parseError(ctx, result.error)
+			return nil, parseError(syms, result.error)
+		}
+		return result.meta, nil
+	}
+})
+
+const symOperatorStatWith = "opendal_operator_stat_with"
+
+type operatorStatWith func(op *opendalOperator, path string, opts OperatorOptions) (*opendalMetadata, error)
+
+var withOperatorStatWith = withFFI(ffiOpts{
+	sym:      symOperatorStatWith,
+	rType:    &typeResultStat,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorStatWith {
+	return func(op *opendalOperator, path string, opts OperatorOptions) (*opendalMetadata, error) {
+		bytePath, err := unix.BytePtrFromString(path)
+		if err != nil {
+			return nil, err
+		}
+		options, err := newOperatorOptions(syms, opts)
+		if err != nil {
+			return nil, err
+		}
+		defer syms.operatorOptionsFree(options)
+		var result resultStat
+		ffiCall(
+			unsafe.Pointer(&result),
+			unsafe.Pointer(&op),
+			unsafe.Pointer(&bytePath),
+			unsafe.Pointer(&options),
+		)
+		if result.error != nil {
+			return nil, parseError(syms, result.error)
 		}
 		return result.meta, nil
 	}
@@ -115,10 +386,11 @@ const symOperatorIsExist = "opendal_operator_is_exist"
 type operatorIsExist func(op *opendalOperator, path string) (bool, error)
 
 var withOperatorIsExists = withFFI(ffiOpts{
-	sym:    symOperatorIsExist,
-	rType:  &typeResultIsExist,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorIsExist {
+	sym:      symOperatorIsExist,
+	rType:    &typeResultIsExist,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorIsExist {
 	return func(op *opendalOperator, path string) (bool, error) {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -131,7 +403,7 @@ var withOperatorIsExists = withFFI(ffiOpts{
 			unsafe.Pointer(&bytePath),
 		)
 		if result.error != nil {
-			return false, parseError(ctx, result.error)
+			return false, parseError(syms, result.error)
 		}
 		return result.is_exist == 1, nil
 	}
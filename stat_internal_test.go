@@ -0,0 +1,27 @@
+package opendal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchETag(t *testing.T) {
+	assert := require.New(t)
+
+	for _, c := range []struct {
+		condition, etag string
+		strong, weak    bool
+	}{
+		{`"a"`, `"a"`, true, true},
+		{`"a"`, `"b"`, false, false},
+		{`"b", "a"`, `"a"`, true, true},
+		{`*`, `W/"a"`, true, true},
+		{`W/"a"`, `"a"`, false, true},
+		{`"a"`, `W/"a"`, false, true},
+		{`W/"a"`, `W/"a"`, false, true},
+	} {
+		assert.Equal(c.strong, matchETag(c.condition, c.etag, false), "strong comparison of %s and %s", c.condition, c.etag)
+		assert.Equal(c.weak, matchETag(c.condition, c.etag, true), "weak comparison of %s and %s", c.condition, c.etag)
+	}
+}
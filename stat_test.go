@@ -21,6 +21,10 @@ func testsStat(cap *opendal.Capability) []behaviorTest {
 		testStatNotCleanedPath,
 		testStatNotExist,
 		testStatRoot,
+		testStatOptionalMetadata,
+		testStatWithIfMatch,
+		testStatWithIfNoneMatch,
+		testStatWithVersion,
 	}
 }
 
@@ -122,3 +126,89 @@ func testStatRoot(assert *require.Assertions, op *opendal.Operator, fixture *fix
 	assert.True(meta.IsDir())
 
 }
+
+func testStatOptionalMetadata(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+
+	if etag, ok := meta.ETag(); ok {
+		assert.NotEmpty(etag, "etag must not be empty when present")
+	}
+	if contentType, ok := meta.ContentType(); ok {
+		assert.NotEmpty(contentType, "content type must not be empty when present")
+	}
+	if md5, ok := meta.ContentMD5(); ok {
+		assert.NotEmpty(md5, "content md5 must not be empty when present")
+	}
+	assert.Nil(meta.UserMetadata(), "user metadata was not written")
+}
+
+func testStatWithIfMatch(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	etag, ok := meta.ETag()
+	if !ok {
+		_, err = op.StatWith(path, opendal.StatIfMatch("\"invalid_etag\""))
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err), "conditions can't be checked without an etag")
+		return
+	}
+
+	_, err = op.StatWith(path, opendal.StatIfMatch("\"invalid_etag\""))
+	assert.Equal(opendal.CodeConditionNotMatch, assertErrorCode(err))
+
+	result, err := op.StatWith(path, opendal.StatIfMatch(etag))
+	assert.Nil(err)
+	assert.Equal(meta.ContentLength(), result.ContentLength())
+}
+
+func testStatWithIfNoneMatch(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	etag, ok := meta.ETag()
+	if !ok {
+		_, err = op.StatWith(path, opendal.StatIfNoneMatch("\"invalid_etag\""))
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err), "conditions can't be checked without an etag")
+		return
+	}
+
+	_, err = op.StatWith(path, opendal.StatIfNoneMatch(etag))
+	assert.Equal(opendal.CodeConditionNotMatch, assertErrorCode(err))
+
+	result, err := op.StatWith(path, opendal.StatIfNoneMatch("\"invalid_etag\""))
+	assert.Nil(err)
+	assert.Equal(meta.ContentLength(), result.ContentLength())
+}
+
+func testStatWithVersion(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	assert.Nil(op.Write(path, content), "write must succeed")
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	version, ok := meta.Version()
+	if !ok {
+		_, err = op.StatWith(path, opendal.StatVersion("invalid_version"))
+		assert.NotNil(err, "a version must not be found on a service that doesn't return versions")
+		return
+	}
+
+	result, err := op.StatWith(path, opendal.StatVersion(version))
+	assert.Nil(err)
+	resultVersion, ok := result.Version()
+	assert.True(ok)
+	assert.Equal(version, resultVersion)
+	assert.Equal(meta.ContentLength(), result.ContentLength())
+}
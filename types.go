@@ -79,6 +79,42 @@ var (
 		}[0],
 	}
 
+	typeResultReaderSeek = ffi.Type{
+		Type: ffi.Struct,
+		Elements: &[]*ffi.Type{
+			&ffi.TypeUint64,
+			&ffi.TypePointer,
+			nil,
+		}[0],
+	}
+
+	typeResultOperatorWriter = ffi.Type{
+		Type: ffi.Struct,
+		Elements: &[]*ffi.Type{
+			&ffi.TypePointer,
+			&ffi.TypePointer,
+			nil,
+		}[0],
+	}
+
+	typeResultWriterWrite = ffi.Type{
+		Type: ffi.Struct,
+		Elements: &[]*ffi.Type{
+			&ffi.TypePointer,
+			&ffi.TypePointer,
+			nil,
+		}[0],
+	}
+
+	typeResultPresign = ffi.Type{
+		Type: ffi.Struct,
+		Elements: &[]*ffi.Type{
+			&ffi.TypePointer,
+			&ffi.TypePointer,
+			nil,
+		}[0],
+	}
+
 	typeResultIsExist = ffi.Type{
 		Type: ffi.Struct,
 		Elements: &[]*ffi.Type{
@@ -199,6 +235,25 @@ type resultReaderRead struct {
 	error *opendalError
 }
 
+type opendalWriter struct {
+	inner uintptr
+}
+
+type resultOperatorWriter struct {
+	writer *opendalWriter
+	error  *opendalError
+}
+
+type resultWriterWrite struct {
+	size  uint
+	error *opendalError
+}
+
+type resultReaderSeek struct {
+	pos   uint64
+	error *opendalError
+}
+
 type resultIsExist struct {
 	is_exist uint8
 	error    *opendalError
@@ -0,0 +1,171 @@
+package opendal
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// RegisterScheme makes schemes available to NewOperatorFromURI and ParseURI.
+//
+// A scheme is looked up by its Name. Registering a scheme with the same name as
+// a previously registered one replaces it.
+//
+// # Example
+//
+//	func init() {
+//		opendal.RegisterScheme(memory.Scheme, s3.Scheme)
+//	}
+func RegisterScheme(schemes ...Scheme) {
+	registry.Lock()
+	defer registry.Unlock()
+	for _, scheme := range schemes {
+		registry.schemes[scheme.Name()] = scheme
+	}
+}
+
+var registry = struct {
+	sync.RWMutex
+	schemes map[string]Scheme
+}{
+	schemes: map[string]Scheme{},
+}
+
+// hostOptions maps schemes to the option set by the host of a URI, such as
+// the bucket of "s3://bucket/path".
+var hostOptions = map[string]string{
+	"azblob": "container",
+	"azdls":  "filesystem",
+	"b2":     "bucket",
+	"cos":    "bucket",
+	"gcs":    "bucket",
+	"obs":    "bucket",
+	"oss":    "bucket",
+	"s3":     "bucket",
+	"tos":    "bucket",
+}
+
+// NewOperatorFromURI creates an Operator from a URI such as
+// "s3://bucket/path/to/file?region=us-east-1&root=/prefix".
+//
+// The URI is parsed with ParseURI, so its scheme must have been registered with
+// RegisterScheme.
+//
+// # Parameters
+//
+//   - uri: The URI of the storage and of an object in it.
+//
+// # Returns
+//
+//   - *Operator: A new Operator instance.
+//   - string: The path of the object in the URI, relative to the root of the operator.
+//   - error: An error if the URI is invalid or initialization fails, or nil if successful.
+//
+// # Example
+//
+//	func main() {
+//		opendal.RegisterScheme(s3.Scheme)
+//
+//		op, path, err := opendal.NewOperatorFromURI("s3://bucket/logs/app.log?region=us-east-1")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		defer op.Close()
+//
+//		data, err := op.Read(path)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("Read: %s\n", data)
+//	}
+//
+// Note: Remember to call Close() on the returned Operator when it's no longer needed.
+func NewOperatorFromURI(uri string) (op *Operator, path string, err error) {
+	scheme, opts, path, err := ParseURI(uri)
+	if err != nil {
+		return
+	}
+	op, err = NewOperator(scheme, opts)
+	return
+}
+
+// ParseURI splits a URI into the scheme, the options and the object path used by
+// NewOperatorFromURI.
+//
+// # Parameters
+//
+//   - uri: A URI in the form "scheme://host/path?key=value&...".
+//
+// # Returns
+//
+//   - Scheme: The registered scheme named by the URI. Dashes in the URI scheme match
+//     underscores in the name, so "aliyun-drive://" selects the "aliyun_drive" scheme.
+//   - OperatorOptions: The query parameters of the URI, plus the option set by the host,
+//     such as "bucket" for s3, gcs or oss and "container" for azblob.
+//   - string: The path of the URI without its leading slash.
+//   - error: An *Error with code CodeConfigInvalid if the URI is malformed or its scheme
+//     is not registered.
+//
+// # Notes
+//
+//   - The root of the operator is set with the "root" query parameter. The returned path
+//     is relative to it.
+//   - Credentials should be passed as query parameters, user information such as
+//     "user:password@" is rejected.
+func ParseURI(uri string) (scheme Scheme, opts OperatorOptions, path string, err error) {
+	name, rest, ok := strings.Cut(uri, "://")
+	if !ok || name == "" {
+		err = invalidURI(uri, "missing scheme")
+		return
+	}
+	name = strings.ReplaceAll(strings.ToLower(name), "-", "_")
+
+	registry.RLock()
+	scheme, ok = registry.schemes[name]
+	registry.RUnlock()
+	if !ok {
+		err = invalidURI(uri, "scheme %s is not registered", name)
+		return
+	}
+
+	// The scheme of the URI may not be valid for net/url, such as "aliyun_drive",
+	// so the rest of it is parsed with a placeholder scheme.
+	u, err := url.Parse("opendal://" + rest)
+	if err != nil {
+		err = invalidURI(uri, "%v", err)
+		return
+	}
+	if u.User != nil {
+		err = invalidURI(uri, "user information is not supported")
+		return
+	}
+
+	opts = OperatorOptions{}
+	for key, values := range u.Query() {
+		if len(values) > 1 {
+			err = invalidURI(uri, "option %s is set more than once", key)
+			return
+		}
+		opts[key] = values[0]
+	}
+	if u.Host != "" {
+		key, ok := hostOptions[name]
+		if !ok {
+			err = invalidURI(uri, "scheme %s does not take a host", name)
+			return
+		}
+		opts[key] = u.Host
+	}
+
+	path = strings.TrimPrefix(u.Path, "/")
+	return
+}
+
+// invalidURI returns the error reported by ParseURI for uri.
+func invalidURI(uri string, format string, args ...any) error {
+	return &Error{
+		code:    CodeConfigInvalid,
+		message: fmt.Sprintf("invalid uri %q: ", uri) + fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,73 @@
+package opendal
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeScheme string
+
+func (s fakeScheme) Name() string    { return string(s) }
+func (s fakeScheme) Path() string    { return "" }
+func (s fakeScheme) LoadOnce() error { return nil }
+
+func TestParseURI(t *testing.T) {
+	registry.RLock()
+	schemes := maps.Clone(registry.schemes)
+	registry.RUnlock()
+	t.Cleanup(func() {
+		registry.Lock()
+		registry.schemes = schemes
+		registry.Unlock()
+	})
+	RegisterScheme(fakeScheme("s3"), fakeScheme("azblob"), fakeScheme("fake_fs"))
+
+	cases := []struct {
+		uri    string
+		scheme string
+		opts   OperatorOptions
+		path   string
+		err    bool
+	}{
+		{
+			uri:    "s3://bucket/path/to/file?region=us-east-1&root=/prefix",
+			scheme: "s3",
+			opts:   OperatorOptions{"bucket": "bucket", "region": "us-east-1", "root": "/prefix"},
+			path:   "path/to/file",
+		},
+		{
+			uri:    "azblob://container/dir/?endpoint=https%3A%2F%2Faccount.blob.core.windows.net",
+			scheme: "azblob",
+			opts:   OperatorOptions{"container": "container", "endpoint": "https://account.blob.core.windows.net"},
+			path:   "dir/",
+		},
+		{
+			uri:    "fake-fs:///tmp/file",
+			scheme: "fake_fs",
+			opts:   OperatorOptions{},
+			path:   "tmp/file",
+		},
+		{uri: "s3://bucket/file?region=a&region=b", err: true},
+		{uri: "s3://user:secret@bucket/file", err: true},
+		{uri: "fake_fs://host/file", err: true},
+		{uri: "unknown://bucket/file", err: true},
+		{uri: "/path/without/scheme", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.uri, func(t *testing.T) {
+			assert := require.New(t)
+
+			scheme, opts, path, err := ParseURI(c.uri)
+			if c.err {
+				assert.ErrorIs(err, ErrConfigInvalid)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(c.scheme, scheme.Name())
+			assert.Equal(c.opts, opts)
+			assert.Equal(c.path, path)
+		})
+	}
+}
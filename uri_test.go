@@ -0,0 +1,41 @@
+package opendal_test
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsURI(cap *opendal.Capability) []behaviorTest {
+	if !cap.Read() || !cap.Write() {
+		return nil
+	}
+	return []behaviorTest{
+		testNewOperatorFromURI,
+	}
+}
+
+func testNewOperatorFromURI(assert *require.Assertions, _ *opendal.Operator, fixture *fixture) {
+	scheme := testScheme()
+	opendal.RegisterScheme(scheme)
+
+	query := url.Values{}
+	for key, value := range envOptions(scheme) {
+		query.Set(key, value)
+	}
+	path, content, _ := fixture.NewFile()
+
+	uriOp, uriPath, err := opendal.NewOperatorFromURI(fmt.Sprintf("%s:///%s?%s", scheme.Name(), path, query.Encode()))
+	assert.Nil(err, "create operator from uri must succeed")
+	defer uriOp.Close()
+	assert.Equal(path, uriPath)
+
+	assert.Nil(uriOp.Write(uriPath, content), "write must succeed")
+	defer uriOp.Delete(uriPath)
+
+	data, err := uriOp.Read(uriPath)
+	assert.Nil(err, "read must succeed")
+	assert.Equal(content, data)
+}
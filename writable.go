@@ -0,0 +1,584 @@
+package opendal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// WritableFS returns a writable file system over the Operator.
+//
+// Its method set is modeled on afero.Fs: Create, Open, OpenFile, Mkdir, MkdirAll,
+// Remove, RemoveAll, Rename and Stat are built on Writer, CreateDir, Delete, Rename,
+// Stat, List and Reader. Names are slash-separated and may start with a slash; they
+// are cleaned and resolved against the root of the Operator.
+//
+// # Notes
+//
+//   - Files are opened either for reading or for writing. O_RDWR, WriteAt, Truncate
+//     and partial overwrites of an existing file return an error matching ErrUnsupported
+//     and errors.ErrUnsupported.
+//   - A file opened for writing is written through a Writer and is not guaranteed to be
+//     visible until Close returns successfully. If the loaded C binding doesn't export
+//     `opendal_operator_writer`, the whole file is instead held in memory and written by
+//     Close, and writing more than 64 MiB to it returns an error matching ErrUnsupported.
+//   - Read, Write, Seek and the other methods of a File return an error matching
+//     os.ErrClosed once it is closed.
+//   - O_APPEND needs Capability.WriteCanAppend and the write_with functions of the C
+//     binding, otherwise OpenFile returns an error matching ErrUnsupported.
+//   - O_EXCL is checked with Stat before writing, so it doesn't guard against concurrent
+//     writers.
+//   - Errors are *fs.PathError or *os.LinkError values wrapping an *Error, which can be
+//     checked with errors.Is against fs.ErrNotExist, fs.ErrExist and the sentinel errors.
+//   - Chmod, Chown and Chtimes always return an error matching ErrUnsupported.
+//
+// # Example
+//
+//	func exampleWritableFS(op *opendal.Operator) {
+//		fsys := op.WritableFS()
+//		if err := fsys.MkdirAll("logs", 0o755); err != nil {
+//			log.Fatal(err)
+//		}
+//		f, err := fsys.Create("logs/app.log")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		if _, err := f.WriteString("service started\n"); err != nil {
+//			log.Fatal(err)
+//		}
+//		if err := f.Close(); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) WritableFS() *WritableFS {
+	return &WritableFS{op: op, fs: &ioFS{op: op}}
+}
+
+// WritableFS is a writable file system over an Operator, returned by Operator.WritableFS.
+type WritableFS struct {
+	op *Operator
+	fs *ioFS
+}
+
+// Name returns the scheme of the Operator.
+func (f *WritableFS) Name() string {
+	return f.op.Info().GetScheme()
+}
+
+// Create creates or truncates the named file and opens it for writing.
+//
+// Unlike os.Create, the file is opened with O_WRONLY instead of O_RDWR. If the loaded
+// C binding doesn't export `opendal_operator_writer`, the file is held in memory until
+// Close and can't grow past 64 MiB.
+func (f *WritableFS) Create(name string) (*File, error) {
+	return f.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+// Open opens the named file or directory for reading.
+func (f *WritableFS) Open(name string) (*File, error) {
+	return f.OpenFile(name, os.O_RDONLY, 0)
+}
+
+// OpenFile opens the named file with the given flags. The permission bits are ignored.
+//
+// Files opened with O_RDONLY can be read, seeked and, for directories, listed. Files
+// opened with O_WRONLY are written from the start, and must be opened with O_TRUNC if
+// they already have contents.
+//
+// Files opened with O_WRONLY are held in memory until Close if the loaded C binding
+// doesn't export `opendal_operator_writer`, and writing more than 64 MiB to them
+// returns an error matching ErrUnsupported.
+func (f *WritableFS) OpenFile(name string, flag int, perm fs.FileMode) (*File, error) {
+	switch flag & (os.O_RDONLY | os.O_WRONLY | os.O_RDWR) {
+	case os.O_RDONLY:
+		if flag&(os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: &Error{
+				code:    CodeUnsupported,
+				message: "O_CREATE, O_TRUNC and O_APPEND require O_WRONLY",
+			}}
+		}
+		return f.openRead(name)
+	case os.O_WRONLY:
+		return f.openWrite(name, flag)
+	default:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: &Error{
+			code:    CodeUnsupported,
+			message: "O_RDWR is not supported, open the file with either O_RDONLY or O_WRONLY",
+		}}
+	}
+}
+
+func (f *WritableFS) openRead(name string) (*File, error) {
+	file, err := f.fs.Open(fsName(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: errors.Unwrap(err)}
+	}
+	return &File{name: name, fs: f, file: file}, nil
+}
+
+func (f *WritableFS) openWrite(name string, flag int) (*File, error) {
+	p := cleanPath(name)
+	if p == "" || strings.HasSuffix(name, "/") {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: &Error{
+			code:    CodeIsADirectory,
+			message: "is a directory",
+		}}
+	}
+	_, meta, err := f.fs.stat(fsName(name))
+	switch {
+	case err == nil && meta.IsDir():
+		err = &Error{
+			code:    CodeIsADirectory,
+			message: "is a directory",
+		}
+	case err == nil && flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL:
+		err = &Error{
+			code:    CodeAlreadyExists,
+			message: "file already exists",
+		}
+	case err == nil && flag&(os.O_TRUNC|os.O_APPEND) == 0 && meta.ContentLength() > 0:
+		err = &Error{
+			code:    CodeUnsupported,
+			message: "overwriting part of a file is not supported, open it with O_TRUNC",
+		}
+	case err == nil:
+		// The file is replaced, or the append is rejected by writer.
+	case errors.Is(err, ErrNotFound) && flag&os.O_CREATE != 0:
+		err = nil
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	w, err := f.writer(p, flag&os.O_APPEND != 0)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	return &File{name: name, fs: f, writer: w}, nil
+}
+
+// writer returns a writer for the file at p, which falls back to a bufferedWriter if
+// the C binding doesn't support writers.
+func (f *WritableFS) writer(p string, appending bool) (io.WriteCloser, error) {
+	syms := f.op.syms
+	if !appending {
+		if syms.operatorWriter == nil || syms.writerClose == nil {
+			return &bufferedWriter{op: f.op, path: p}, nil
+		}
+		return f.op.Writer(p)
+	}
+	// Fail when the file is opened rather than on Close if the append can't be done.
+	if err := f.op.checkWriteOptions(&writeOptions{append: true}); err != nil {
+		return nil, err
+	}
+	if syms.operatorWriterWith == nil || syms.writerClose == nil {
+		if syms.operatorWriteWith == nil {
+			return nil, errUnsupported(symOperatorWriteWith)
+		}
+		return &bufferedWriter{op: f.op, path: p, append: true}, nil
+	}
+	return f.op.WriterWith(p, WriteAppend())
+}
+
+// Mkdir creates the named directory. Its parent directory must exist.
+// The permission bits are ignored.
+func (f *WritableFS) Mkdir(name string, perm fs.FileMode) error {
+	p := cleanPath(name)
+	if _, _, err := f.fs.stat(fsName(name)); err == nil {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: &Error{
+			code:    CodeAlreadyExists,
+			message: "file already exists",
+		}}
+	} else if !errors.Is(err, ErrNotFound) {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: err}
+	}
+	if parent := path.Dir(p); parent != "." {
+		_, meta, err := f.fs.stat(parent)
+		if err == nil && !meta.IsDir() {
+			err = &Error{
+				code:    CodeNotADirectory,
+				message: "parent is not a directory",
+			}
+		}
+		if err != nil {
+			return &fs.PathError{Op: "mkdir", Path: name, Err: err}
+		}
+	}
+	if err := f.op.CreateDir(p + "/"); err != nil {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: err}
+	}
+	return nil
+}
+
+// MkdirAll creates the named directory along with any missing parents. It returns nil
+// if the directory already exists. The permission bits are ignored.
+//
+// Like os.MkdirAll, each existing ancestor is checked with Stat, and a file in place of
+// one of them is reported as a *fs.PathError wrapping an error matching ErrNotADirectory.
+func (f *WritableFS) MkdirAll(name string, perm fs.FileMode) error {
+	p := cleanPath(name)
+	if p == "" {
+		return nil
+	}
+	elems := strings.Split(p, "/")
+	for i := range elems {
+		dir := strings.Join(elems[:i+1], "/")
+		_, meta, err := f.fs.stat(dir)
+		switch {
+		case err == nil && meta.IsDir():
+			continue
+		case err == nil:
+			err = &Error{
+				code:    CodeNotADirectory,
+				message: "not a directory",
+			}
+		case errors.Is(err, ErrNotFound):
+			// Nothing exists below a missing directory, so the rest is created at once.
+			dir = name
+			err = f.op.CreateDir(p + "/")
+		}
+		if i == len(elems)-1 {
+			dir = name
+		}
+		if err != nil {
+			return &fs.PathError{Op: "mkdir", Path: dir, Err: err}
+		}
+		return nil
+	}
+	return nil
+}
+
+// Remove removes the named file or empty directory.
+func (f *WritableFS) Remove(name string) error {
+	p, meta, err := f.fs.stat(fsName(name))
+	if err == nil && p == "" {
+		err = &Error{
+			code:    CodePermissioDenied,
+			message: "cannot remove the root directory",
+		}
+	}
+	if err == nil && meta.IsDir() {
+		var empty bool
+		if empty, err = f.isEmptyDir(p); err == nil && !empty {
+			err = errDirNotEmpty
+		}
+	}
+	if err == nil {
+		err = f.op.Delete(p)
+	}
+	if err != nil {
+		return &fs.PathError{Op: "remove", Path: name, Err: err}
+	}
+	return nil
+}
+
+var errDirNotEmpty = errors.New("directory not empty")
+
+func (f *WritableFS) isEmptyDir(p string) (bool, error) {
+	lister, err := f.op.List(p)
+	if err != nil {
+		return false, err
+	}
+	defer lister.Close()
+	for lister.Next() {
+		if lister.Entry().Path() != p {
+			return false, nil
+		}
+	}
+	return true, lister.Error()
+}
+
+// RemoveAll removes the named file or directory and everything under it.
+// It returns nil if the name does not exist.
+func (f *WritableFS) RemoveAll(name string) error {
+	p, _, err := f.fs.stat(fsName(name))
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err == nil {
+		err = f.op.RemoveAll(p)
+	}
+	if err != nil {
+		return &fs.PathError{Op: "removeall", Path: name, Err: err}
+	}
+	return nil
+}
+
+// Rename renames the file oldname to newname, replacing newname if it exists.
+// Directories cannot be renamed.
+func (f *WritableFS) Rename(oldname, newname string) error {
+	_, meta, err := f.fs.stat(fsName(oldname))
+	if err == nil && meta.IsDir() {
+		err = &Error{
+			code:    CodeUnsupported,
+			message: "renaming directories is not supported",
+		}
+	}
+	if err == nil {
+		err = f.op.Rename(cleanPath(oldname), cleanPath(newname))
+	}
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: err}
+	}
+	return nil
+}
+
+// Stat returns the FileInfo of the named file or directory. Its Sys method returns
+// the *Metadata of the file.
+func (f *WritableFS) Stat(name string) (fs.FileInfo, error) {
+	_, meta, err := f.fs.stat(fsName(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+	return &fileInfo{name: path.Base("/" + cleanPath(name)), meta: meta}, nil
+}
+
+// Chmod is not supported and always returns an error.
+func (f *WritableFS) Chmod(name string, mode fs.FileMode) error {
+	return &fs.PathError{Op: "chmod", Path: name, Err: &Error{
+		code:    CodeUnsupported,
+		message: "file modes are not supported",
+	}}
+}
+
+// Chown is not supported and always returns an error.
+func (f *WritableFS) Chown(name string, uid, gid int) error {
+	return &fs.PathError{Op: "chown", Path: name, Err: &Error{
+		code:    CodeUnsupported,
+		message: "file owners are not supported",
+	}}
+}
+
+// Chtimes is not supported and always returns an error.
+func (f *WritableFS) Chtimes(name string, atime, mtime time.Time) error {
+	return &fs.PathError{Op: "chtimes", Path: name, Err: &Error{
+		code:    CodeUnsupported,
+		message: "file times are not supported",
+	}}
+}
+
+// File is a file opened from a WritableFS, either for reading or for writing.
+//
+// Its method set is modeled on afero.File.
+type File struct {
+	name   string
+	fs     *WritableFS
+	closed bool
+
+	// file is set if the file was opened for reading.
+	file fs.File
+	// writer is set if the file was opened for writing.
+	writer io.WriteCloser
+}
+
+// Name returns the name of the file as passed to OpenFile.
+func (f *File) Name() string {
+	return f.name
+}
+
+func (f *File) Read(buf []byte) (int, error) {
+	if f.closed {
+		return 0, f.error("read", os.ErrClosed)
+	}
+	if f.file == nil {
+		return 0, f.error("read", &Error{
+			code:    CodeUnsupported,
+			message: "file is opened for writing",
+		})
+	}
+	return f.file.Read(buf)
+}
+
+func (f *File) ReadAt(buf []byte, off int64) (int, error) {
+	if f.closed {
+		return 0, f.error("read", os.ErrClosed)
+	}
+	r, ok := f.file.(io.ReaderAt)
+	if !ok {
+		return 0, f.error("read", &Error{
+			code:    CodeUnsupported,
+			message: "file is not a regular file opened for reading",
+		})
+	}
+	return r.ReadAt(buf, off)
+}
+
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	if f.closed {
+		return 0, f.error("seek", os.ErrClosed)
+	}
+	s, ok := f.file.(io.Seeker)
+	if !ok {
+		return 0, f.error("seek", &Error{
+			code:    CodeUnsupported,
+			message: "file is not a regular file opened for reading",
+		})
+	}
+	return s.Seek(offset, whence)
+}
+
+func (f *File) Write(buf []byte) (int, error) {
+	if f.closed {
+		return 0, f.error("write", os.ErrClosed)
+	}
+	if f.writer == nil {
+		return 0, f.error("write", &Error{
+			code:    CodeUnsupported,
+			message: "file is opened for reading",
+		})
+	}
+	return f.writer.Write(buf)
+}
+
+func (f *File) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+// WriteAt is not supported and always returns an error.
+func (f *File) WriteAt(buf []byte, off int64) (int, error) {
+	return 0, f.error("write", &Error{
+		code:    CodeUnsupported,
+		message: "random writes are not supported",
+	})
+}
+
+// Truncate is not supported and always returns an error.
+func (f *File) Truncate(size int64) error {
+	return f.error("truncate", &Error{
+		code:    CodeUnsupported,
+		message: "truncating files is not supported",
+	})
+}
+
+// Sync does nothing: the data of a file opened for writing is committed by Close.
+func (f *File) Sync() error {
+	return nil
+}
+
+// Readdir returns the FileInfo of up to count entries of the directory, or of all
+// remaining entries if count <= 0, like os.File.Readdir.
+func (f *File) Readdir(count int) ([]fs.FileInfo, error) {
+	entries, err := f.readDir(count)
+	infos := make([]fs.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return infos, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, err
+}
+
+// Readdirnames returns the names of up to n entries of the directory, or of all
+// remaining entries if n <= 0, like os.File.Readdirnames.
+func (f *File) Readdirnames(n int) ([]string, error) {
+	entries, err := f.readDir(n)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names, err
+}
+
+func (f *File) readDir(n int) ([]fs.DirEntry, error) {
+	if f.closed {
+		return nil, f.error("readdir", os.ErrClosed)
+	}
+	dir, ok := f.file.(*ioDir)
+	if !ok {
+		return nil, f.error("readdir", &Error{
+			code:    CodeNotADirectory,
+			message: "not a directory",
+		})
+	}
+	return dir.ReadDir(n)
+}
+
+// Stat returns the FileInfo of the file. For a file opened for writing, it reports
+// the file as last committed to the storage.
+func (f *File) Stat() (fs.FileInfo, error) {
+	if f.file != nil {
+		return f.file.Stat()
+	}
+	return f.fs.Stat(f.name)
+}
+
+// Close closes the file. For a file opened for writing, it commits the data written.
+func (f *File) Close() error {
+	if f.closed {
+		return f.error("close", os.ErrClosed)
+	}
+	f.closed = true
+	if f.writer != nil {
+		return f.writer.Close()
+	}
+	return f.file.Close()
+}
+
+func (f *File) error(op string, err error) error {
+	return &fs.PathError{Op: op, Path: f.name, Err: err}
+}
+
+// maxBufferedWrite is the largest file a bufferedWriter holds in memory.
+const maxBufferedWrite = 64 << 20
+
+// bufferedWriter holds the data of a file in memory and writes it on Close, for C
+// bindings that don't support writers. The C binding can only write a file from a
+// single buffer, so the data can't be streamed; files larger than maxBufferedWrite
+// are rejected rather than exhausting memory.
+type bufferedWriter struct {
+	op     *Operator
+	path   string
+	append bool
+	buf    bytes.Buffer
+	// err is set once a Write is rejected, so that Close doesn't write a partial file.
+	err error
+}
+
+func (w *bufferedWriter) Write(buf []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if w.buf.Len()+len(buf) > maxBufferedWrite {
+		w.err = &Error{
+			code: CodeUnsupported,
+			message: fmt.Sprintf("files larger than %d MiB are not supported, as %s is not exported by the loaded C binding",
+				maxBufferedWrite>>20, symOperatorWriter),
+		}
+		return 0, w.err
+	}
+	return w.buf.Write(buf)
+}
+
+func (w *bufferedWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.append {
+		return w.op.Append(w.path, w.buf.Bytes())
+	}
+	return w.op.Write(w.path, w.buf.Bytes())
+}
+
+// cleanPath returns the Operator path of name, without leading or trailing slashes.
+// The root is the empty path.
+func cleanPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+// fsName returns name as a valid fs.FS name.
+func fsName(name string) string {
+	if p := cleanPath(name); p != "" {
+		return p
+	}
+	return "."
+}
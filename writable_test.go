@@ -0,0 +1,313 @@
+package opendal_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/stretchr/testify/require"
+	"go.yuchanns.xyz/opendal"
+)
+
+func testsWritableFS(cap *opendal.Capability) []behaviorTest {
+	if !cap.Write() || !cap.Read() || !cap.Stat() || !cap.List() || !cap.Delete() {
+		return nil
+	}
+	tests := []behaviorTest{
+		testWritableFSCreate,
+		testWritableFSOpenFileFlags,
+		testWritableFSAppend,
+		testWritableFSReaddir,
+		testWritableFSRemove,
+		testWritableFSUnsupported,
+		testWritableFSClosed,
+		testWritableFSLargeFile,
+	}
+	if cap.CreateDir() {
+		tests = append(tests, testWritableFSMkdir)
+	}
+	if cap.Rename() {
+		tests = append(tests, testWritableFSRename)
+	}
+	return tests
+}
+
+func writeFile(assert *require.Assertions, fsys *opendal.WritableFS, name string, content []byte) {
+	f, err := fsys.Create(name)
+	assert.Nil(err)
+	n, err := f.Write(content)
+	assert.Nil(err)
+	assert.Equal(len(content), n)
+	assert.Nil(f.Close())
+}
+
+func readFile(assert *require.Assertions, fsys *opendal.WritableFS, name string) []byte {
+	f, err := fsys.Open(name)
+	assert.Nil(err)
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	assert.Nil(err)
+	return data
+}
+
+func testWritableFSCreate(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	path, content, size := fixture.NewFile()
+
+	writeFile(assert, fsys, "/"+path, content)
+
+	info, err := fsys.Stat(path)
+	assert.Nil(err)
+	assert.Equal(path, info.Name())
+	assert.Equal(int64(size), info.Size())
+	assert.False(info.IsDir())
+	assert.Equal(content, readFile(assert, fsys, path))
+
+	// Create truncates existing files.
+	writeFile(assert, fsys, path, []byte("replaced"))
+	assert.Equal([]byte("replaced"), readFile(assert, fsys, path))
+}
+
+func testWritableFSOpenFileFlags(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	path, content, _ := fixture.NewFile()
+
+	_, err := fsys.OpenFile(path, os.O_WRONLY, 0)
+	assert.True(errors.Is(err, fs.ErrNotExist), "open without O_CREATE must fail with fs.ErrNotExist: %v", err)
+	_, err = fsys.Open(path)
+	assert.True(errors.Is(err, fs.ErrNotExist), "open must fail with fs.ErrNotExist: %v", err)
+
+	f, err := fsys.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	assert.Nil(err)
+	_, err = f.Write(content)
+	assert.Nil(err)
+	assert.Nil(f.Close())
+	assert.True(errors.Is(f.Close(), fs.ErrClosed))
+
+	_, err = fsys.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	assert.True(errors.Is(err, fs.ErrExist), "open with O_EXCL must fail with fs.ErrExist: %v", err)
+
+	_, err = fsys.OpenFile(path, os.O_RDWR, 0)
+	assert.True(errors.Is(err, opendal.ErrUnsupported), "open with O_RDWR must fail with ErrUnsupported: %v", err)
+	assert.True(errors.Is(err, errors.ErrUnsupported))
+	assert.Contains(err.Error(), "O_RDWR")
+
+	_, err = fsys.OpenFile(path, os.O_WRONLY, 0)
+	assert.True(errors.Is(err, opendal.ErrUnsupported), "overwriting part of a file must fail with ErrUnsupported: %v", err)
+
+	f, err = fsys.OpenFile(path, os.O_WRONLY|os.O_TRUNC, 0)
+	assert.Nil(err)
+	_, err = f.WriteString("truncated")
+	assert.Nil(err)
+	assert.Nil(f.Close())
+	assert.Equal([]byte("truncated"), readFile(assert, fsys, path))
+}
+
+func testWritableFSAppend(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	path := fixture.NewFilePath()
+	writeFile(assert, fsys, path, []byte("hello"))
+
+	f, err := fsys.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		assert.True(errors.Is(err, opendal.ErrUnsupported), "append must fail with ErrUnsupported: %v", err)
+		assert.Equal([]byte("hello"), readFile(assert, fsys, path), "failed append must not change the file")
+		return
+	}
+	_, err = f.WriteString(" world")
+	assert.Nil(err)
+	assert.Nil(f.Close())
+	assert.Equal([]byte("hello world"), readFile(assert, fsys, path))
+}
+
+func testWritableFSReaddir(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	parent := fixture.NewDirPath()
+	for _, name := range []string{"a", "b", "c/d"} {
+		path, content, _ := fixture.NewFileWithRange(parent+name, 1, 256)
+		writeFile(assert, fsys, path, content)
+	}
+
+	dir, err := fsys.Open(parent)
+	assert.Nil(err)
+	defer dir.Close()
+
+	info, err := dir.Stat()
+	assert.Nil(err)
+	assert.True(info.IsDir())
+
+	infos, err := dir.Readdir(2)
+	assert.Nil(err)
+	assert.Len(infos, 2)
+	assert.Equal("a", infos[0].Name())
+	assert.Equal("b", infos[1].Name())
+	assert.False(infos[1].IsDir())
+
+	names, err := dir.Readdirnames(-1)
+	assert.Nil(err)
+	assert.Equal([]string{"c"}, names)
+
+	_, err = dir.Readdir(1)
+	assert.Equal(io.EOF, err)
+
+	_, err = dir.Read(make([]byte, 1))
+	assert.NotNil(err)
+}
+
+func testWritableFSRemove(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	parent := fixture.NewDirPath()
+	path, content, _ := fixture.NewFileWithRange(parent+"a", 1, 256)
+	writeFile(assert, fsys, path, content)
+	nested, content, _ := fixture.NewFileWithRange(parent+"b/c", 1, 256)
+	writeFile(assert, fsys, nested, content)
+
+	err := fsys.Remove(parent + "b")
+	assert.NotNil(err, "removing a non-empty directory must fail")
+
+	assert.Nil(fsys.Remove(path))
+	_, err = fsys.Stat(path)
+	assert.True(errors.Is(err, fs.ErrNotExist), "stat must fail with fs.ErrNotExist: %v", err)
+
+	err = fsys.Remove(path)
+	assert.True(errors.Is(err, fs.ErrNotExist), "remove must fail with fs.ErrNotExist: %v", err)
+
+	assert.Nil(fsys.RemoveAll(parent))
+	_, err = fsys.Stat(nested)
+	assert.True(errors.Is(err, fs.ErrNotExist), "stat must fail with fs.ErrNotExist: %v", err)
+	assert.Nil(fsys.RemoveAll(parent))
+}
+
+func testWritableFSUnsupported(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	path, content, _ := fixture.NewFile()
+
+	f, err := fsys.Create(path)
+	assert.Nil(err)
+	_, err = f.WriteAt(content, 0)
+	assert.True(errors.Is(err, opendal.ErrUnsupported), "WriteAt must fail with ErrUnsupported: %v", err)
+	_, err = f.Read(make([]byte, 1))
+	assert.True(errors.Is(err, opendal.ErrUnsupported), "Read must fail with ErrUnsupported: %v", err)
+	assert.True(errors.Is(f.Truncate(0), opendal.ErrUnsupported))
+	_, err = f.Write(content)
+	assert.Nil(err)
+	assert.Nil(f.Close())
+
+	f, err = fsys.Open(path)
+	assert.Nil(err)
+	_, err = f.Write(content)
+	assert.True(errors.Is(err, opendal.ErrUnsupported), "Write must fail with ErrUnsupported: %v", err)
+	assert.Nil(f.Close())
+
+	assert.True(errors.Is(fsys.Chmod(path, 0o644), opendal.ErrUnsupported))
+}
+
+func testWritableFSMkdir(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	parent := fixture.NewDirPath()
+
+	err := fsys.Mkdir(parent+"a/b", 0o755)
+	assert.True(errors.Is(err, fs.ErrNotExist), "mkdir without parent must fail with fs.ErrNotExist: %v", err)
+
+	assert.Nil(fsys.MkdirAll(parent+"a/b", 0o755))
+	assert.Nil(fsys.MkdirAll(parent+"a/b", 0o755))
+	fixture.PushPath(parent + "a/b/")
+	fixture.PushPath(parent + "a/")
+
+	info, err := fsys.Stat(parent + "a/b")
+	assert.Nil(err)
+	assert.True(info.IsDir())
+	assert.Equal("b", info.Name())
+
+	err = fsys.Mkdir(parent+"a", 0o755)
+	assert.True(errors.Is(err, fs.ErrExist), "mkdir must fail with fs.ErrExist: %v", err)
+
+	assert.Nil(fsys.Mkdir(parent+"c", 0o755))
+	fixture.PushPath(parent + "c/")
+	info, err = fsys.Stat(parent + "c")
+	assert.Nil(err)
+	assert.True(info.IsDir())
+
+	_, err = fsys.Create(parent + "c")
+	assert.True(errors.Is(err, opendal.ErrIsADirectory), "create must fail with ErrIsADirectory: %v", err)
+
+	assert.Nil(fsys.Remove(parent + "c"))
+	_, err = fsys.Stat(parent + "c")
+	assert.True(errors.Is(err, fs.ErrNotExist), "stat must fail with fs.ErrNotExist: %v", err)
+
+	file := parent + "a/file"
+	writeFile(assert, fsys, file, []byte("hello"))
+	defer func() { assert.Nil(fsys.Remove(file)) }()
+	err = fsys.MkdirAll(file+"/d/e", 0o755)
+	var pathErr *fs.PathError
+	assert.True(errors.As(err, &pathErr), "mkdir under a file must fail with a *fs.PathError: %v", err)
+	assert.Equal("mkdir", pathErr.Op)
+	assert.Equal(file, pathErr.Path)
+	assert.True(errors.Is(err, opendal.ErrNotADirectory), "mkdir under a file must fail with ErrNotADirectory: %v", err)
+	_, err = fsys.Stat(file + "/d")
+	assert.True(errors.Is(err, fs.ErrNotExist), "no directory must be created under a file: %v", err)
+}
+
+func testWritableFSRename(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	src, content, _ := fixture.NewFile()
+	dest := fixture.NewFilePath()
+	writeFile(assert, fsys, src, content)
+
+	assert.Nil(fsys.Rename(src, dest))
+
+	_, err := fsys.Stat(src)
+	assert.True(errors.Is(err, fs.ErrNotExist), "stat must fail with fs.ErrNotExist: %v", err)
+	assert.Equal(content, readFile(assert, fsys, dest))
+
+	err = fsys.Rename(src, dest)
+	var linkErr *os.LinkError
+	assert.True(errors.As(err, &linkErr))
+	assert.True(errors.Is(err, fs.ErrNotExist), "rename must fail with fs.ErrNotExist: %v", err)
+}
+
+func testWritableFSClosed(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	path, content, _ := fixture.NewFile()
+
+	f, err := fsys.Create(path)
+	assert.Nil(err)
+	assert.Nil(f.Close())
+	_, err = f.Write(content)
+	assert.True(errors.Is(err, os.ErrClosed), "write after close must fail with os.ErrClosed: %v", err)
+
+	f, err = fsys.Open(path)
+	assert.Nil(err)
+	assert.Nil(f.Close())
+	_, err = f.Read(make([]byte, 1))
+	assert.True(errors.Is(err, os.ErrClosed), "read after close must fail with os.ErrClosed: %v", err)
+	_, err = f.ReadAt(make([]byte, 1), 0)
+	assert.True(errors.Is(err, os.ErrClosed), "read at after close must fail with os.ErrClosed: %v", err)
+	_, err = f.Seek(0, io.SeekStart)
+	assert.True(errors.Is(err, os.ErrClosed), "seek after close must fail with os.ErrClosed: %v", err)
+}
+
+// testWritableFSLargeFile writes a file larger than what is held in memory when the
+// C binding has no writer.
+func testWritableFSLargeFile(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	fsys := op.WritableFS()
+	path := fixture.NewFilePath()
+	content := make([]byte, 64<<20+1)
+
+	f, err := fsys.Create(path)
+	assert.Nil(err)
+	_, err = f.Write(content)
+	if err != nil {
+		assert.True(errors.Is(err, opendal.ErrUnsupported), "large write must fail with ErrUnsupported: %v", err)
+		assert.Contains(err.Error(), "opendal_operator_writer")
+		assert.True(errors.Is(f.Close(), opendal.ErrUnsupported), "close must not write a partial file")
+		_, err = fsys.Stat(path)
+		assert.True(errors.Is(err, fs.ErrNotExist), "the file must not be written: %v", err)
+		return
+	}
+	assert.Nil(f.Close())
+	info, err := fsys.Stat(path)
+	assert.Nil(err)
+	assert.Equal(int64(len(content)), info.Size())
+}
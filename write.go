@@ -2,6 +2,9 @@ package opendal
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"os"
 	"unsafe"
 
 	"github.com/jupiterrider/ffi"
@@ -11,9 +14,8 @@ import (
 // Write writes the given bytes to the specified path.
 //
 // Write is a wrapper around the C-binding function `opendal_operator_write`. It provides a simplified
-// interface for writing data to the storage. Currently, this implementation does not support the
-// `Operator::write_with` method from the original Rust library, nor does it support streaming writes
-// or multipart uploads.
+// interface for writing data to the storage. To set the content type or other attributes of the
+// file, use WriteWith instead. For streaming writes or multipart uploads, use the Writer() method.
 //
 // # Parameters
 //
@@ -35,8 +37,529 @@ import (
 //
 // Note: This example assumes proper error handling and import statements.
 func (op *Operator) Write(path string, data []byte) error {
-	write := getFFI[operatorWrite](op.ctx, symOperatorWrite)
-	return write(op.inner, path, data)
+	return op.WriteContext(context.Background(), path, data)
+}
+
+// WriteContext is like Write but honors the deadline and cancellation of ctx.
+//
+// If ctx is done before the write starts, WriteContext returns an error wrapping
+// ctx.Err() and nothing is written. A write that has started cannot be aborted:
+// it runs to completion and its result is returned.
+func (op *Operator) WriteContext(ctx context.Context, path string, data []byte) error {
+	return op.intercept(ctx, &Call{Operation: "write", Path: path, Args: []any{data}}, func(ctx context.Context) error {
+		write := op.syms.operatorWrite
+		if write == nil {
+			return errUnsupported(symOperatorWrite)
+		}
+		return callContextErr(ctx, "write", func() error {
+			return write(op.inner, path, data)
+		})
+	})
+}
+
+// WriteWith writes the given bytes to the specified path with the given options.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_write_with`.
+//
+// # Parameters
+//
+//   - path: The destination path where the bytes will be written.
+//   - data: The byte slice containing the data to be written.
+//   - opts: Options such as WriteContentType, WriteContentDisposition, WriteCacheControl,
+//     WriteUserMetadata, WriteIfNotExists or WriteAppend.
+//
+// # Returns
+//
+//   - *Metadata: Metadata of the written file, such as its ETag or version if the backend reports them.
+//   - error: An error if the write operation fails, or nil if successful.
+//
+// # Notes
+//
+//   - WriteContentType, WriteContentDisposition, WriteCacheControl and WriteAppend require
+//     the matching capability, otherwise an error with code opendal.CodeUnsupported is returned before
+//     anything is written.
+//   - With WriteIfNotExists, writing to an existing path returns an error with code
+//     opendal.CodeConditionNotMatch.
+//   - If the loaded C binding does not export `opendal_operator_write_with`, WriteWith without
+//     options is a Write followed by a Stat, and any option returns an error with code
+//     opendal.CodeUnsupported.
+//
+// # Example
+//
+//	func exampleWriteWith(op *opendal.Operator) {
+//		meta, err := op.WriteWith("index.html", []byte("<h1>Hello</h1>"),
+//			opendal.WriteContentType("text/html"),
+//			opendal.WriteCacheControl("max-age=3600"),
+//			opendal.WriteUserMetadata(map[string]string{"owner": "web"}),
+//			opendal.WriteIfNotExists(),
+//		)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		etag, _ := meta.ETag()
+//		fmt.Printf("Written with ETag %s\n", etag)
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) WriteWith(path string, data []byte, opts ...WriteOption) (*Metadata, error) {
+	return op.WriteWithContext(context.Background(), path, data, opts...)
+}
+
+// WriteWithContext is like WriteWith but honors the deadline and cancellation of ctx.
+//
+// If ctx is done before the write starts, WriteWithContext returns an error wrapping
+// ctx.Err(). Once started, the write cannot be aborted and runs to completion.
+func (op *Operator) WriteWithContext(ctx context.Context, path string, data []byte, opts ...WriteOption) (*Metadata, error) {
+	return interceptValue(op, ctx, &Call{Operation: "write_with", Path: path, Args: []any{data, opts}}, func(ctx context.Context) (*Metadata, error) {
+		return op.writeWith(ctx, path, data, newWriteOptions(opts))
+	})
+}
+
+func (op *Operator) writeWith(ctx context.Context, path string, data []byte, o *writeOptions) (*Metadata, error) {
+	if err := op.checkWriteOptions(o); err != nil {
+		return nil, err
+	}
+	writeWith := op.syms.operatorWriteWith
+	if writeWith == nil {
+		if !o.empty() {
+			return nil, errUnsupported(symOperatorWriteWith)
+		}
+		if err := op.WriteContext(ctx, path, data); err != nil {
+			return nil, err
+		}
+		return op.StatContext(ctx, path)
+	}
+	return callContext(ctx, "write", func() (*Metadata, error) {
+		meta, err := writeWith(op.inner, path, data, o.operatorOptions())
+		if err != nil {
+			return nil, err
+		}
+		return newMetadata(op.syms, meta), nil
+	})
+}
+
+// Append appends the given bytes to the end of the file at the specified path.
+//
+// Append is a shortcut for WriteWith with the WriteAppend option. If the file does not
+// exist, it is created.
+//
+// # Parameters
+//
+//   - path: The path of the file to append to.
+//   - data: The byte slice containing the data to be appended.
+//
+// # Returns
+//
+//   - error: An error if the append operation fails, or nil if successful.
+//
+// # Notes
+//
+//   - Requires Capability.WriteCanAppend and `opendal_operator_write_with` in the loaded
+//     C binding, otherwise an error with code opendal.CodeUnsupported is returned before
+//     anything is written. The append is never emulated with a read and a write, which
+//     would race with other writers.
+//   - To append a stream of data, use WriterWith with the WriteAppend option.
+//
+// # Example
+//
+//	func exampleAppend(op *opendal.Operator) {
+//		err := op.Append("app.log", []byte("service started\n"))
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) Append(path string, data []byte) error {
+	return op.AppendContext(context.Background(), path, data)
+}
+
+// AppendContext is like Append but honors the deadline and cancellation of ctx.
+func (op *Operator) AppendContext(ctx context.Context, path string, data []byte) error {
+	return op.intercept(ctx, &Call{Operation: "append", Path: path, Args: []any{data}}, func(ctx context.Context) error {
+		_, err := op.writeWith(ctx, path, data, &writeOptions{append: true})
+		return err
+	})
+}
+
+// checkWriteOptions fails early for options that the service does not support.
+func (op *Operator) checkWriteOptions(o *writeOptions) error {
+	info := op.Info()
+	cap := info.GetFullCapability()
+	for _, option := range []struct {
+		name      string
+		set       bool
+		supported bool
+	}{
+		{"content type", o.contentType != "", cap.WriteWithContentType()},
+		{"content disposition", o.contentDisposition != "", cap.WriteWithContentDisposition()},
+		{"cache control", o.cacheControl != "", cap.WriteWithCacheControl()},
+		{"append", o.append, cap.WriteCanAppend()},
+	} {
+		if option.set && !option.supported {
+			return &Error{
+				code:    CodeUnsupported,
+				message: fmt.Sprintf("%s is not supported by service %s", option.name, info.GetScheme()),
+			}
+		}
+	}
+	return nil
+}
+
+// WriteOption configures WriteWith and WriterWith.
+type WriteOption func(o *writeOptions)
+
+// WriteContentType sets the Content-Type of the file.
+//
+// Requires Capability.WriteWithContentType.
+func WriteContentType(v string) WriteOption {
+	return func(o *writeOptions) {
+		o.contentType = v
+	}
+}
+
+// WriteContentDisposition sets the Content-Disposition of the file.
+//
+// Requires Capability.WriteWithContentDisposition.
+func WriteContentDisposition(v string) WriteOption {
+	return func(o *writeOptions) {
+		o.contentDisposition = v
+	}
+}
+
+// WriteCacheControl sets the Cache-Control of the file.
+//
+// Requires Capability.WriteWithCacheControl.
+func WriteCacheControl(v string) WriteOption {
+	return func(o *writeOptions) {
+		o.cacheControl = v
+	}
+}
+
+// WriteUserMetadata attaches user-defined metadata to the file.
+// Calling it more than once merges the given pairs.
+func WriteUserMetadata(metadata map[string]string) WriteOption {
+	return func(o *writeOptions) {
+		if o.userMetadata == nil {
+			o.userMetadata = make(map[string]string, len(metadata))
+		}
+		for key, value := range metadata {
+			o.userMetadata[key] = value
+		}
+	}
+}
+
+// WriteIfNotExists only writes the file if nothing exists at the path yet.
+func WriteIfNotExists() WriteOption {
+	return func(o *writeOptions) {
+		o.ifNotExists = true
+	}
+}
+
+// WriteAppend appends the data to the end of the file instead of replacing it.
+// If the file does not exist, it is created.
+//
+// Requires Capability.WriteCanAppend.
+func WriteAppend() WriteOption {
+	return func(o *writeOptions) {
+		o.append = true
+	}
+}
+
+type writeOptions struct {
+	contentType        string
+	contentDisposition string
+	cacheControl       string
+	userMetadata       map[string]string
+	ifNotExists        bool
+	append             bool
+}
+
+func newWriteOptions(opts []WriteOption) *writeOptions {
+	o := &writeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *writeOptions) empty() bool {
+	return o.contentType == "" && o.contentDisposition == "" && o.cacheControl == "" &&
+		len(o.userMetadata) == 0 && !o.ifNotExists && !o.append
+}
+
+func (o *writeOptions) operatorOptions() OperatorOptions {
+	opts := OperatorOptions{}
+	setOption(opts, "content_type", o.contentType)
+	setOption(opts, "content_disposition", o.contentDisposition)
+	setOption(opts, "cache_control", o.cacheControl)
+	for key, value := range o.userMetadata {
+		opts["user_metadata."+key] = value
+	}
+	if o.ifNotExists {
+		opts["if_not_exists"] = "true"
+	}
+	if o.append {
+		opts["append"] = "true"
+	}
+	return opts
+}
+
+// Writer creates a new Writer for streaming data to the specified path.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_writer`.
+//
+// # Parameters
+//
+//   - path: The destination path where the data will be written.
+//
+// # Returns
+//
+//   - *OperatorWriter: A writer for uploading the file's contents. It implements `io.WriteCloser`
+//     and `io.ReaderFrom`.
+//   - error: An error if the writer creation fails, or nil if successful.
+//
+// # Notes
+//
+//   - Data is flushed to the underlying storage chunk by chunk, so large objects can be
+//     uploaded in bounded memory. Backends with `WriteCanMulti` use multipart uploads.
+//   - The file is not guaranteed to be visible until Close returns successfully.
+//   - If the loaded C binding does not export the writer API, an error with code
+//     opendal.CodeUnsupported will be returned.
+//   - To set the content type or other attributes of the file, use WriterWith instead.
+//
+// # Example
+//
+//	func exampleWriter(op *opendal.Operator) {
+//		w, err := op.Writer("path/to/file")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//
+//		f, err := os.Open("path/to/local/file")
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		defer f.Close()
+//
+//		_, err = io.Copy(w, f)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		err = w.Close()
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Note: This example assumes proper error handling and import statements.
+func (op *Operator) Writer(path string) (*OperatorWriter, error) {
+	return op.WriterContext(context.Background(), path)
+}
+
+// WriterContext is like Writer but honors the deadline and cancellation of ctx.
+//
+// The returned writer keeps ctx: once ctx is done, its Write method returns an
+// error wrapping ctx.Err(). Close still has to be called to release resources,
+// and the file is not committed if any Write failed.
+func (op *Operator) WriterContext(ctx context.Context, path string) (*OperatorWriter, error) {
+	w, err := interceptValue(op, ctx, &Call{Operation: "writer", Path: path}, func(ctx context.Context) (*OperatorWriter, error) {
+		return op.writerWith(ctx, path, newWriteOptions(nil))
+	})
+	if err == nil {
+		w.intercepted = op.intercepting(ctx)
+	}
+	return w, err
+}
+
+// WriterWith creates a new Writer for the specified path with the given options.
+//
+// This function is a wrapper around the C-binding function `opendal_operator_writer_with`.
+// It accepts the same options as WriteWith. Without options, it is the same as Writer.
+func (op *Operator) WriterWith(path string, opts ...WriteOption) (*OperatorWriter, error) {
+	return op.WriterWithContext(context.Background(), path, opts...)
+}
+
+// WriterWithContext is like WriterWith but honors the deadline and cancellation of ctx.
+func (op *Operator) WriterWithContext(ctx context.Context, path string, opts ...WriteOption) (*OperatorWriter, error) {
+	w, err := interceptValue(op, ctx, &Call{Operation: "writer_with", Path: path, Args: []any{opts}}, func(ctx context.Context) (*OperatorWriter, error) {
+		return op.writerWith(ctx, path, newWriteOptions(opts))
+	})
+	if err == nil {
+		w.intercepted = op.intercepting(ctx)
+	}
+	return w, err
+}
+
+func (op *Operator) writerWith(ctx context.Context, path string, o *writeOptions) (*OperatorWriter, error) {
+	if err := op.checkWriteOptions(o); err != nil {
+		return nil, err
+	}
+	switch {
+	case op.syms.writerWrite == nil:
+		return nil, errUnsupported(symWriterWrite)
+	case op.syms.writerClose == nil:
+		return nil, errUnsupported(symWriterClose)
+	case op.syms.writerFree == nil:
+		return nil, errUnsupported(symWriterFree)
+	}
+	var getWriter func() (*opendalWriter, error)
+	if o.empty() {
+		writer := op.syms.operatorWriter
+		if writer == nil {
+			return nil, errUnsupported(symOperatorWriter)
+		}
+		getWriter = func() (*opendalWriter, error) {
+			return writer(op.inner, path)
+		}
+	} else {
+		writerWith := op.syms.operatorWriterWith
+		if writerWith == nil {
+			return nil, errUnsupported(symOperatorWriterWith)
+		}
+		getWriter = func() (*opendalWriter, error) {
+			return writerWith(op.inner, path, o.operatorOptions())
+		}
+	}
+	return callContext(ctx, "writer", func() (*OperatorWriter, error) {
+		inner, err := getWriter()
+		if err != nil {
+			return nil, err
+		}
+		writer := &OperatorWriter{
+			inner:   inner,
+			op:      op,
+			callCtx: ctx,
+			path:    path,
+		}
+		return writer, nil
+	})
+}
+
+// writerChunkSize is the size of the buffer used by OperatorWriter.ReadFrom.
+const writerChunkSize = 256 * 1024
+
+type OperatorWriter struct {
+	inner   *opendalWriter
+	op      *Operator // hold the op pointer to ensure it is gc after OperatorWriter instance.
+	callCtx context.Context
+	path    string
+	closed  bool
+	// err is the error of the first failed Write. The data is not committed once it is set.
+	err error
+
+	// intercepted is set if Write and Close go through the interceptors of op.
+	intercepted bool
+}
+
+var (
+	_ io.WriteCloser = (*OperatorWriter)(nil)
+	_ io.ReaderFrom  = (*OperatorWriter)(nil)
+)
+
+// Write writes len(buf) bytes from buf to the underlying storage.
+//
+// This method implements the io.Writer interface for OperatorWriter.
+//
+// # Returns
+//
+//   - int: The number of bytes written. It is always len(buf) unless an error occurs.
+//   - error: An error if the write operation fails, or nil if successful. Once a Write
+//     has failed, every later Write returns the same error, and after Close, Write
+//     returns os.ErrClosed.
+func (w *OperatorWriter) Write(buf []byte) (n int, err error) {
+	if !w.intercepted {
+		n, err = w.write(buf)
+		return n, annotate(err, &Call{Operation: "writer.write", Path: w.path})
+	}
+	err = w.op.invoke(w.callCtx, &Call{Operation: "writer.write", Path: w.path, Args: []any{buf}}, func(context.Context) (err error) {
+		n, err = w.write(buf)
+		return
+	})
+	return
+}
+
+func (w *OperatorWriter) write(buf []byte) (int, error) {
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	write := w.op.syms.writerWrite
+	var total int
+	for total < len(buf) {
+		if err := w.callCtx.Err(); err != nil {
+			w.err = contextError("write", err)
+			return total, w.err
+		}
+		size, err := write(w.inner, buf[total:])
+		total += int(size)
+		if err != nil {
+			w.err = err
+			return total, err
+		}
+		if size == 0 {
+			return total, io.ErrShortWrite
+		}
+	}
+	return total, nil
+}
+
+// ReadFrom reads data from r until io.EOF and writes it to the underlying storage.
+//
+// This method implements the io.ReaderFrom interface, so io.Copy uses it to
+// stream data through a single fixed-size buffer.
+func (w *OperatorWriter) ReadFrom(r io.Reader) (int64, error) {
+	buf := make([]byte, writerChunkSize)
+	var total int64
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			written, werr := w.Write(buf[:n])
+			total += int64(written)
+			if werr != nil {
+				return total, werr
+			}
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// Close completes the write and releases resources associated with the OperatorWriter.
+//
+// The written data is committed to the storage only if Close returns nil.
+// If a previous Write failed, the data is discarded and Close returns an error
+// wrapping the error of that Write. Calling Close again returns os.ErrClosed.
+func (w *OperatorWriter) Close() error {
+	if w.closed {
+		return os.ErrClosed
+	}
+	if !w.intercepted {
+		return annotate(w.close(), &Call{Operation: "writer.close", Path: w.path})
+	}
+	return w.op.invoke(w.callCtx, &Call{Operation: "writer.close", Path: w.path}, func(context.Context) error {
+		return w.close()
+	})
+}
+
+func (w *OperatorWriter) close() error {
+	if w.closed {
+		return os.ErrClosed
+	}
+	w.closed = true
+	free := w.op.syms.writerFree
+	defer free(w.inner)
+	if w.err != nil {
+		return fmt.Errorf("opendal: %s was not committed after a failed write: %w", w.path, w.err)
+	}
+	closeWriter := w.op.syms.writerClose
+	return closeWriter(w.inner)
 }
 
 // CreateDir creates a directory at the specified path.
@@ -75,8 +598,20 @@ func (op *Operator) Write(path string, data []byte) error {
 // Note: This example assumes proper error handling and import statements.
 // The trailing slash in "test/" is important to indicate it's a directory.
 func (op *Operator) CreateDir(path string) error {
-	createDir := getFFI[operatorCreateDir](op.ctx, symOperatorCreateDir)
-	return createDir(op.inner, path)
+	return op.CreateDirContext(context.Background(), path)
+}
+
+// CreateDirContext is like CreateDir but honors the deadline and cancellation of ctx.
+func (op *Operator) CreateDirContext(ctx context.Context, path string) error {
+	return op.intercept(ctx, &Call{Operation: "create_dir", Path: path}, func(ctx context.Context) error {
+		createDir := op.syms.operatorCreateDir
+		if createDir == nil {
+			return errUnsupported(symOperatorCreateDir)
+		}
+		return callContextErr(ctx, "create_dir", func() error {
+			return createDir(op.inner, path)
+		})
+	})
 }
 
 const symOperatorWrite = "opendal_operator_write"
@@ -84,10 +619,11 @@ const symOperatorWrite = "opendal_operator_write"
 type operatorWrite func(op *opendalOperator, path string, data []byte) error
 
 var withOperatorWrite = withFFI(ffiOpts{
-	sym:    symOperatorWrite,
-	rType:  &ffi.TypePointer,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &typeBytes},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorWrite {
+	sym:      symOperatorWrite,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &typeBytes},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorWrite {
 	return func(op *opendalOperator, path string, data []byte) error {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -104,7 +640,7 @@ var withOperatorWrite = withFFI(ffiOpts{
 			unsafe.Pointer(&bytePath),
 			unsafe.Pointer(&bytes),
 		)
-		return parseError(ctx, e)
+		return parseError(syms, e)
 	}
 })
 
@@ -113,10 +649,11 @@ const symOperatorCreateDir = "opendal_operator_create_dir"
 type operatorCreateDir func(op *opendalOperator, path string) error
 
 var withOperatorCreateDir = withFFI(ffiOpts{
-	sym:    symOperatorCreateDir,
-	rType:  &ffi.TypePointer,
-	aTypes: []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
-}, func(ctx context.Context, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorCreateDir {
+	sym:      symOperatorCreateDir,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorCreateDir {
 	return func(op *opendalOperator, path string) error {
 		bytePath, err := unix.BytePtrFromString(path)
 		if err != nil {
@@ -128,6 +665,167 @@ var withOperatorCreateDir = withFFI(ffiOpts{
 			unsafe.Pointer(&op),
 			unsafe.Pointer(&bytePath),
 		)
-		return parseError(ctx, e)
+		return parseError(syms, e)
+	}
+})
+
+const symOperatorWriter = "opendal_operator_writer"
+
+type operatorWriter func(op *opendalOperator, path string) (*opendalWriter, error)
+
+var withOperatorWriter = withFFI(ffiOpts{
+	sym:      symOperatorWriter,
+	rType:    &typeResultOperatorWriter,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorWriter {
+	return func(op *opendalOperator, path string) (*opendalWriter, error) {
+		bytePath, err := unix.BytePtrFromString(path)
+		if err != nil {
+			return nil, err
+		}
+		var result resultOperatorWriter
+		ffiCall(
+			unsafe.Pointer(&result),
+			unsafe.Pointer(&op),
+			unsafe.Pointer(&bytePath),
+		)
+		if result.error != nil {
+			return nil, parseError(syms, result.error)
+		}
+		return result.writer, nil
+	}
+})
+
+const symWriterWrite = "opendal_writer_write"
+
+type writerWrite func(w *opendalWriter, data []byte) (size uint, err error)
+
+var withWriterWrite = withFFI(ffiOpts{
+	sym:      symWriterWrite,
+	rType:    &typeResultWriterWrite,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &typeBytes},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) writerWrite {
+	return func(w *opendalWriter, data []byte) (size uint, err error) {
+		bytes := toOpendalBytes(data)
+		var result resultWriterWrite
+		ffiCall(
+			unsafe.Pointer(&result),
+			unsafe.Pointer(&w),
+			unsafe.Pointer(&bytes),
+		)
+		if result.error != nil {
+			return 0, parseError(syms, result.error)
+		}
+		return result.size, nil
+	}
+})
+
+const symWriterClose = "opendal_writer_close"
+
+type writerClose func(w *opendalWriter) error
+
+var withWriterClose = withFFI(ffiOpts{
+	sym:      symWriterClose,
+	rType:    &ffi.TypePointer,
+	aTypes:   []*ffi.Type{&ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) writerClose {
+	return func(w *opendalWriter) error {
+		var e *opendalError
+		ffiCall(
+			unsafe.Pointer(&e),
+			unsafe.Pointer(&w),
+		)
+		return parseError(syms, e)
+	}
+})
+
+const symWriterFree = "opendal_writer_free"
+
+type writerFree func(w *opendalWriter)
+
+var withWriterFree = withFFI(ffiOpts{
+	sym:      symWriterFree,
+	rType:    &ffi.TypeVoid,
+	aTypes:   []*ffi.Type{&ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) writerFree {
+	return func(w *opendalWriter) {
+		ffiCall(
+			nil,
+			unsafe.Pointer(&w),
+		)
+	}
+})
+
+const symOperatorWriteWith = "opendal_operator_write_with"
+
+type operatorWriteWith func(op *opendalOperator, path string, data []byte, opts OperatorOptions) (*opendalMetadata, error)
+
+var withOperatorWriteWith = withFFI(ffiOpts{
+	sym:      symOperatorWriteWith,
+	rType:    &typeResultStat,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &typeBytes, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorWriteWith {
+	return func(op *opendalOperator, path string, data []byte, opts OperatorOptions) (*opendalMetadata, error) {
+		bytePath, err := unix.BytePtrFromString(path)
+		if err != nil {
+			return nil, err
+		}
+		options, err := newOperatorOptions(syms, opts)
+		if err != nil {
+			return nil, err
+		}
+		defer syms.operatorOptionsFree(options)
+		bytes := toOpendalBytes(data)
+		var result resultStat
+		ffiCall(
+			unsafe.Pointer(&result),
+			unsafe.Pointer(&op),
+			unsafe.Pointer(&bytePath),
+			unsafe.Pointer(&bytes),
+			unsafe.Pointer(&options),
+		)
+		if result.error != nil {
+			return nil, parseError(syms, result.error)
+		}
+		return result.meta, nil
+	}
+})
+
+const symOperatorWriterWith = "opendal_operator_writer_with"
+
+type operatorWriterWith func(op *opendalOperator, path string, opts OperatorOptions) (*opendalWriter, error)
+
+var withOperatorWriterWith = withFFI(ffiOpts{
+	sym:      symOperatorWriterWith,
+	rType:    &typeResultOperatorWriter,
+	aTypes:   []*ffi.Type{&ffi.TypePointer, &ffi.TypePointer, &ffi.TypePointer},
+	optional: true,
+}, func(syms *symbols, ffiCall func(rValue unsafe.Pointer, aValues ...unsafe.Pointer)) operatorWriterWith {
+	return func(op *opendalOperator, path string, opts OperatorOptions) (*opendalWriter, error) {
+		bytePath, err := unix.BytePtrFromString(path)
+		if err != nil {
+			return nil, err
+		}
+		options, err := newOperatorOptions(syms, opts)
+		if err != nil {
+			return nil, err
+		}
+		defer syms.operatorOptionsFree(options)
+		var result resultOperatorWriter
+		ffiCall(
+			unsafe.Pointer(&result),
+			unsafe.Pointer(&op),
+			unsafe.Pointer(&bytePath),
+			unsafe.Pointer(&options),
+		)
+		if result.error != nil {
+			return nil, parseError(syms, result.error)
+		}
+		return result.writer, nil
 	}
 })
@@ -1,6 +1,9 @@
 package opendal_test
 
 import (
+	"bytes"
+	"os"
+
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.yuchanns.xyz/opendal"
@@ -16,6 +19,16 @@ func testsWrite(cap *opendal.Capability) []behaviorTest {
 		testWriteWithDirPath,
 		testWriteWithSpecialChars,
 		testWriteOverwrite,
+		testWriteWithMetadata,
+		testWriteWithContentType,
+		testWriteWithUserMetadata,
+		testWriteWithIfNotExists,
+		testWriteWithUnsupportedOption,
+		testWriterWrite,
+		testWriterReadFrom,
+		testWriterWithContentType,
+		testAppend,
+		testWriterAppend,
 	}
 }
 
@@ -80,3 +93,245 @@ func testWriteOverwrite(assert *require.Assertions, op *opendal.Operator, fixtur
 	assert.NotEqual(contentOne, bs, "content_one must be overwrote")
 	assert.Equal(contentTwo, bs, "read content_two")
 }
+
+// writeWithSupported reports whether err comes from a C binding that exports
+// `opendal_operator_write_with`. Without it, WriteWith must fail with an error saying
+// that the symbol is missing as soon as an option is given.
+func writeWithSupported(assert *require.Assertions, err error) bool {
+	if err == nil || assertErrorCode(err) != opendal.CodeUnsupported {
+		return true
+	}
+	assert.ErrorIs(err, opendal.ErrUnsupported)
+	assert.Contains(err.Error(), "opendal_operator_write")
+	assert.Contains(err.Error(), "is not exported by the loaded C binding")
+	return false
+}
+
+func testWriteWithMetadata(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFile()
+
+	meta, err := op.WriteWith(path, content)
+	assert.Nil(err, "write without options must succeed")
+	assert.True(meta.IsFile())
+	assert.Equal(uint64(size), meta.ContentLength())
+
+	bs, err := op.Read(path)
+	assert.Nil(err)
+	assert.Equal(content, bs)
+}
+
+func testWriteWithContentType(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	if !op.Info().GetFullCapability().WriteWithContentType() {
+		return
+	}
+	path, content, size := fixture.NewFile()
+
+	meta, err := op.WriteWith(path, content, opendal.WriteContentType("text/plain"))
+	if !writeWithSupported(assert, err) {
+		exist, err := op.IsExist(path)
+		assert.Nil(err)
+		assert.False(exist, "an unsupported write must not write anything")
+		return
+	}
+	assert.Nil(err)
+	assert.Equal(uint64(size), meta.ContentLength())
+
+	meta, err = op.Stat(path)
+	assert.Nil(err)
+	if contentType, ok := meta.ContentType(); ok {
+		assert.Equal("text/plain", contentType)
+	}
+}
+
+func testWriteWithUserMetadata(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+	userMetadata := map[string]string{"location": "everywhere", "owner": "web"}
+
+	_, err := op.WriteWith(path, content, opendal.WriteUserMetadata(userMetadata))
+	if !writeWithSupported(assert, err) {
+		return
+	}
+	assert.Nil(err)
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	if got := meta.UserMetadata(); got != nil {
+		assert.Equal(userMetadata, got)
+	}
+}
+
+func testWriteWithIfNotExists(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, _ := fixture.NewFile()
+
+	_, err := op.WriteWith(path, content, opendal.WriteIfNotExists())
+	if !writeWithSupported(assert, err) {
+		return
+	}
+	assert.Nil(err)
+
+	_, err = op.WriteWith(path, genFixedBytes(16), opendal.WriteIfNotExists())
+	assert.NotNil(err)
+	assert.Equal(opendal.CodeConditionNotMatch, assertErrorCode(err))
+
+	bs, err := op.Read(path)
+	assert.Nil(err)
+	assert.Equal(content, bs, "the file must not be replaced")
+}
+
+func testWriteWithUnsupportedOption(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	cap := op.Info().GetFullCapability()
+	path, content, _ := fixture.NewFile()
+
+	for _, c := range []struct {
+		option    opendal.WriteOption
+		supported bool
+	}{
+		{opendal.WriteContentType("text/plain"), cap.WriteWithContentType()},
+		{opendal.WriteContentDisposition("attachment"), cap.WriteWithContentDisposition()},
+		{opendal.WriteCacheControl("no-cache"), cap.WriteWithCacheControl()},
+	} {
+		if c.supported {
+			continue
+		}
+		_, err := op.WriteWith(path, content, c.option)
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err))
+		assert.Contains(err.Error(), "is not supported by service")
+	}
+	exist, err := op.IsExist(path)
+	assert.Nil(err)
+	assert.False(exist, "an unsupported option must fail before writing")
+}
+
+// writerSupported reports whether err comes from a C binding that exports the writer
+// API. The service libraries bundled for the tests don't, so Writer must fail with
+// an error saying which symbol is missing.
+func writerSupported(assert *require.Assertions, err error) bool {
+	if err == nil || assertErrorCode(err) != opendal.CodeUnsupported {
+		return true
+	}
+	assert.ErrorIs(err, opendal.ErrUnsupported)
+	assert.Contains(err.Error(), "is not exported by the loaded C binding")
+	return false
+}
+
+func testWriterWrite(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path := fixture.NewFilePath()
+
+	w, err := op.Writer(path)
+	if !writerSupported(assert, err) {
+		return
+	}
+	assert.Nil(err)
+
+	size := uint(5 * 1024 * 1024)
+	contentOne, contentTwo := genFixedBytes(size), genFixedBytes(size)
+
+	n, err := w.Write(contentOne)
+	assert.Nil(err)
+	assert.Equal(len(contentOne), n)
+	n, err = w.Write(contentTwo)
+	assert.Nil(err)
+	assert.Equal(len(contentTwo), n)
+	assert.Nil(w.Close())
+	assert.ErrorIs(w.Close(), os.ErrClosed, "second close must not commit again")
+	_, err = w.Write(contentOne)
+	assert.ErrorIs(err, os.ErrClosed)
+
+	bs, err := op.Read(path)
+	assert.Nil(err, "read must succeed")
+	assert.Equal(uint(len(bs)), size*2, "read size")
+	assert.Equal(contentOne, bs[:size], "read content_one")
+	assert.Equal(contentTwo, bs[size:], "read content_two")
+}
+
+func testWriterReadFrom(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, content, size := fixture.NewFile()
+
+	w, err := op.Writer(path)
+	if !writerSupported(assert, err) {
+		return
+	}
+	assert.Nil(err)
+
+	n, err := w.ReadFrom(bytes.NewReader(content))
+	assert.Nil(err)
+	assert.Equal(int64(size), n)
+	assert.Nil(w.Close())
+
+	meta, err := op.Stat(path)
+	assert.Nil(err, "stat must succeed")
+	assert.Equal(uint64(size), meta.ContentLength())
+}
+
+func testAppend(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	path, contentOne, _ := fixture.NewFile()
+	contentTwo := genFixedBytes(1024)
+
+	err := op.Append(path, contentOne)
+	if !op.Info().GetFullCapability().WriteCanAppend() {
+		assert.NotNil(err, "append must fail without the capability")
+		assert.Equal(opendal.CodeUnsupported, assertErrorCode(err))
+		assert.Contains(err.Error(), "is not supported by service")
+	}
+	if !op.Info().GetFullCapability().WriteCanAppend() || !writeWithSupported(assert, err) {
+		exist, err := op.IsExist(path)
+		assert.Nil(err)
+		assert.False(exist, "failed append must not write anything")
+		return
+	}
+	assert.Nil(err, "append must create the file")
+	assert.Nil(op.Append(path, contentTwo), "append must succeed")
+
+	data, err := op.Read(path)
+	assert.Nil(err, "read must succeed")
+	assert.Equal(append(contentOne, contentTwo...), data)
+}
+
+func testWriterAppend(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	if !op.Info().GetFullCapability().WriteCanAppend() {
+		return
+	}
+	path, contentOne, _ := fixture.NewFile()
+	contentTwo := genFixedBytes(1024)
+
+	w, err := op.WriterWith(path, opendal.WriteAppend())
+	if !writerSupported(assert, err) {
+		return
+	}
+	assert.Nil(err, "writer must be created")
+	for _, content := range [][]byte{contentOne, contentTwo} {
+		_, err = w.Write(content)
+		assert.Nil(err, "write must succeed")
+	}
+	assert.Nil(w.Close(), "close must succeed")
+
+	w, err = op.WriterWith(path, opendal.WriteAppend())
+	assert.Nil(err, "writer must be created")
+	_, err = w.Write(contentOne)
+	assert.Nil(err, "write must succeed")
+	assert.Nil(w.Close(), "close must succeed")
+
+	data, err := op.Read(path)
+	assert.Nil(err, "read must succeed")
+	assert.Equal(append(append(contentOne, contentTwo...), contentOne...), data)
+}
+
+func testWriterWithContentType(assert *require.Assertions, op *opendal.Operator, fixture *fixture) {
+	if !op.Info().GetFullCapability().WriteWithContentType() {
+		return
+	}
+	path, content, size := fixture.NewFile()
+
+	w, err := op.WriterWith(path, opendal.WriteContentType("text/plain"))
+	if !writerSupported(assert, err) {
+		return
+	}
+	assert.Nil(err)
+	_, err = w.Write(content)
+	assert.Nil(err)
+	assert.Nil(w.Close())
+
+	meta, err := op.Stat(path)
+	assert.Nil(err)
+	assert.Equal(uint64(size), meta.ContentLength())
+}